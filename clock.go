@@ -0,0 +1,138 @@
+package wrappers
+
+import (
+	"fmt"
+	"time"
+)
+
+// Clock provides the current time. It is used by wrappers that validate values relative to now so tests can inject a fixed time.
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc adapts a function to the Clock interface.
+type ClockFunc func() time.Time
+
+func (clock ClockFunc) Now() time.Time {
+	return clock()
+}
+
+// SystemClock is the default clock returning time.Now.
+var SystemClock Clock = ClockFunc(time.Now)
+
+// TimeConstraints holds bounds for time based wrappers. It is embedded in WrapperTime, WrapperTimeISO8601 and WrapperDate.
+// All constraints are optional and evaluated relative to the configured clock.
+type TimeConstraints struct {
+	clock  Clock          // Clock used to determine now. Defaults to SystemClock.
+	past   bool           // If true, the value has to be before now.
+	future bool           // If true, the value has to be after now.
+	min    *time.Time     // If set, the value may not be before this time.
+	max    *time.Time     // If set, the value may not be after this time.
+	within *time.Duration // If set, the value has to be within this duration of now in either direction.
+}
+
+// SetClock sets the clock used to evaluate the past, future and within constraints.
+func (constraints *TimeConstraints) SetClock(clock Clock) {
+	constraints.clock = clock
+}
+
+// SetPast requires the value to be in the past.
+func (constraints *TimeConstraints) SetPast(past bool) {
+	constraints.past = past
+}
+
+// SetFuture requires the value to be in the future.
+func (constraints *TimeConstraints) SetFuture(future bool) {
+	constraints.future = future
+}
+
+// SetMin requires the value to not be before the given time.
+func (constraints *TimeConstraints) SetMin(min time.Time) {
+	constraints.min = &min
+}
+
+// SetMax requires the value to not be after the given time.
+func (constraints *TimeConstraints) SetMax(max time.Time) {
+	constraints.max = &max
+}
+
+// SetWithin requires the value to be within the given duration of now, either before or after.
+func (constraints *TimeConstraints) SetWithin(within time.Duration) {
+	constraints.within = &within
+}
+
+func (constraints *TimeConstraints) now() time.Time {
+	if constraints.clock == nil {
+		return SystemClock.Now()
+	}
+
+	return constraints.clock.Now()
+}
+
+// checkTime validates a time against the constraints. The returned error describes the expectation.
+func (constraints *TimeConstraints) checkTime(value time.Time) error {
+	if constraints.min != nil && value.Before(*constraints.min) {
+		return fmt.Errorf("time not before %s", constraints.min.Format(time.RFC3339))
+	}
+
+	if constraints.max != nil && value.After(*constraints.max) {
+		return fmt.Errorf("time not after %s", constraints.max.Format(time.RFC3339))
+	}
+
+	if !constraints.past && !constraints.future && constraints.within == nil {
+		return nil
+	}
+
+	now := constraints.now()
+
+	if constraints.past && !value.Before(now) {
+		return fmt.Errorf("time in the past")
+	}
+
+	if constraints.future && !value.After(now) {
+		return fmt.Errorf("time in the future")
+	}
+
+	if constraints.within != nil {
+		difference := value.Sub(now)
+		if difference < -*constraints.within || difference > *constraints.within {
+			return fmt.Errorf("time within %s of now", *constraints.within)
+		}
+	}
+
+	return nil
+}
+
+// checkDate validates a date against the constraints. Now, min and max are reduced to their date in their own location.
+func (constraints *TimeConstraints) checkDate(value Date) error {
+	if constraints.min != nil && value.Before(DateOf(*constraints.min)) {
+		return fmt.Errorf("date not before %s", DateOf(*constraints.min))
+	}
+
+	if constraints.max != nil && value.After(DateOf(*constraints.max)) {
+		return fmt.Errorf("date not after %s", DateOf(*constraints.max))
+	}
+
+	if !constraints.past && !constraints.future && constraints.within == nil {
+		return nil
+	}
+
+	today := DateOf(constraints.now())
+
+	if constraints.past && !value.Before(today) {
+		return fmt.Errorf("date in the past")
+	}
+
+	if constraints.future && !value.After(today) {
+		return fmt.Errorf("date in the future")
+	}
+
+	if constraints.within != nil {
+		difference := value.Time(time.UTC).Sub(today.Time(time.UTC))
+		if difference < -*constraints.within || difference > *constraints.within {
+			return fmt.Errorf("date within %s of today", *constraints.within)
+		}
+	}
+
+	return nil
+}
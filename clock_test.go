@@ -0,0 +1,173 @@
+package wrappers
+
+import (
+	"testing"
+	"time"
+)
+
+// TestTimeConstraints tests the time bound constraints of WrapperTime, WrapperTimeISO8601 and WrapperDate.
+func TestTimeConstraints(t *testing.T) {
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+	clock := ClockFunc(func() time.Time { return now })
+
+	tests := []struct {
+		name        string
+		wrapper     func() WrapperProvider
+		input       any
+		discard     bool
+		wantError   bool
+		wantDiscard bool
+	}{
+		{
+			name: "WrapperTime in the past",
+			wrapper: func() WrapperProvider {
+				w := New[*WrapperTime]()
+				w.SetClock(clock)
+				w.SetPast(true)
+				return w
+			},
+			input: "2024-06-15T11:59:59Z",
+		},
+		{
+			name: "WrapperTime not in the past",
+			wrapper: func() WrapperProvider {
+				w := New[*WrapperTime]()
+				w.SetClock(clock)
+				w.SetPast(true)
+				return w
+			},
+			input:       "2024-06-15T12:00:00Z",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name: "WrapperTime not in the future with discard",
+			wrapper: func() WrapperProvider {
+				w := New[*WrapperTime]()
+				w.SetClock(clock)
+				w.SetFuture(true)
+				return w
+			},
+			input:       now.Add(-time.Hour),
+			discard:     true,
+			wantError:   false,
+			wantDiscard: true,
+		},
+		{
+			name: "WrapperTime within window",
+			wrapper: func() WrapperProvider {
+				w := New[*WrapperTime]()
+				w.SetClock(clock)
+				w.SetWithin(5 * time.Minute)
+				return w
+			},
+			input: "2024-06-15T12:04:00Z",
+		},
+		{
+			name: "WrapperTime outside of window",
+			wrapper: func() WrapperProvider {
+				w := New[*WrapperTime]()
+				w.SetClock(clock)
+				w.SetWithin(5 * time.Minute)
+				return w
+			},
+			input:       "2024-06-15T11:54:00Z",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name: "WrapperTimeISO8601 min compared across offsets",
+			wrapper: func() WrapperProvider {
+				w := New[*WrapperTimeISO8601]()
+				w.SetMin(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+				return w
+			},
+			input:       "2023-12-31T23:00:00-02:00",
+			wantError:   false,
+			wantDiscard: false,
+		},
+		{
+			name: "WrapperTimeISO8601 after max",
+			wrapper: func() WrapperProvider {
+				w := New[*WrapperTimeISO8601]()
+				w.SetMax(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+				return w
+			},
+			input:       "2024-01-01T00:30:00+00:00",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name: "WrapperDate birthday in the past",
+			wrapper: func() WrapperProvider {
+				w := New[*WrapperDate]()
+				w.SetClock(clock)
+				w.SetPast(true)
+				return w
+			},
+			input: "1990-07-15",
+		},
+		{
+			name: "WrapperDate today is not in the past",
+			wrapper: func() WrapperProvider {
+				w := New[*WrapperDate]()
+				w.SetClock(clock)
+				w.SetPast(true)
+				return w
+			},
+			input:       "2024-06-15",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name: "WrapperDate due date within a week",
+			wrapper: func() WrapperProvider {
+				w := New[*WrapperDate]()
+				w.SetClock(clock)
+				w.SetFuture(true)
+				w.SetWithin(7 * 24 * time.Hour)
+				return w
+			},
+			input: "2024-06-22",
+		},
+		{
+			name: "WrapperDate due date too far ahead",
+			wrapper: func() WrapperProvider {
+				w := New[*WrapperDate]()
+				w.SetClock(clock)
+				w.SetFuture(true)
+				w.SetWithin(7 * 24 * time.Hour)
+				return w
+			},
+			input:       "2024-06-23",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name: "WrapperDate before min",
+			wrapper: func() WrapperProvider {
+				w := New[*WrapperDate]()
+				w.SetMin(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC))
+				return w
+			},
+			input:       "1899-12-31",
+			wantError:   true,
+			wantDiscard: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapper := tt.wrapper()
+
+			err := wrapper.Wrap(tt.input, tt.discard)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("Wrap() error = %v, wantError %v", err, tt.wantError)
+			}
+
+			if wrapper.IsDiscarded() != tt.wantDiscard {
+				t.Errorf("IsDiscarded() = %v, want %v", wrapper.IsDiscarded(), tt.wantDiscard)
+			}
+		})
+	}
+}
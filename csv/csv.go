@@ -0,0 +1,126 @@
+// Package csv decodes CSV records into structs of wrappers and encodes them back.
+// Header columns are mapped onto struct fields by the csv tag and every cell is validated by the wrapper of its field.
+package csv
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/zealsprince/wrappers"
+	"github.com/zealsprince/wrappers/internal/fields"
+)
+
+const (
+	TagName     = "csv"      // Struct tag holding the column name. Untagged fields use their field name and "-" skips a field.
+	TagRequired = "required" // Option of the csv tag, e.g. `csv:"email,required"`, that reports empty cells.
+)
+
+// ErrRequired is reported for empty cells of required columns.
+var ErrRequired = errors.New("required cell is empty")
+
+// InvalidRowMode defines how rows with invalid cells are handled while decoding.
+type InvalidRowMode int
+
+const (
+	InvalidRowAbort   InvalidRowMode = iota // Decoding stops at the first invalid row and returns its errors.
+	InvalidRowSkip                          // Invalid rows are returned with their errors and marked as skipped.
+	InvalidRowDiscard                       // Invalid cells are discarded and reported while the row is kept.
+)
+
+// CellError describes an invalid cell. The error is commonly a *wrappers.ValidationError.
+type CellError struct {
+	Row    int    // Number of the data row, starting at 1 for the first row after the header.
+	Line   int    // Line of the cell in the input, starting at 1.
+	Column string // Name of the column as given by the header.
+	Err    error
+}
+
+func (e *CellError) Error() string {
+	return fmt.Sprintf("row %d, column %q: %v", e.Row, e.Column, e.Err)
+}
+
+func (e *CellError) Unwrap() error {
+	return e.Err
+}
+
+// RowError aggregates the cell errors of a row. It is returned when decoding is aborted.
+type RowError struct {
+	Row    int
+	Errors []*CellError
+}
+
+func (e *RowError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		messages[i] = err.Error()
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+func (e *RowError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = err
+	}
+
+	return errs
+}
+
+// Row is the result of decoding a single record.
+type Row[T any] struct {
+	Number  int          // Number of the data row, starting at 1 for the first row after the header.
+	Line    int          // Line of the record in the input, starting at 1.
+	Value   T            // The decoded struct. Wrappers of invalid cells are discarded.
+	Errors  []*CellError // Errors of the invalid cells of the row.
+	Skipped bool         // The row is invalid and should be skipped. Only set with InvalidRowSkip.
+}
+
+// Valid checks if all cells of the row are valid.
+func (row *Row[T]) Valid() bool {
+	return len(row.Errors) == 0
+}
+
+// options returns the options used to walk structs of wrappers. Nested structs are joined with a dot, e.g. "address.city".
+func options(allocate bool) fields.Options {
+	return fields.Options{
+		Tag:      TagName,
+		Join:     func(prefix, name string) string { return prefix + "." + name },
+		Allocate: allocate,
+	}
+}
+
+// columns returns the column names of the struct type in field order.
+func columns(value any) ([]string, error) {
+	var names []string
+
+	err := fields.Walk(value, options(false), func(field fields.Field) error {
+		if field.IsSlice() {
+			return fmt.Errorf("column %q: slices of wrappers are not supported", field.Name)
+		}
+
+		names = append(names, field.Name)
+		return nil
+	})
+
+	return names, err
+}
+
+// cell wraps the content of a cell into the wrapper. Empty cells discard the wrapper.
+func cell(wrapper wrappers.WrapperProvider, content string, required bool) error {
+	if content == "" {
+		wrapper.Discard()
+		if required {
+			return ErrRequired
+		}
+		return nil
+	}
+
+	if err := fields.Set(wrapper, content); err != nil {
+		wrapper.Discard()
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,262 @@
+package csv
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/zealsprince/wrappers"
+	"github.com/zealsprince/wrappers/regex"
+)
+
+type address struct {
+	City    *wrappers.WrapperString  `csv:"city"`
+	Country *wrappers.WrapperCountry `csv:"country"`
+}
+
+type customer struct {
+	ID       *wrappers.WrapperInt     `csv:"id,required"`
+	Email    *regex.WrapperRegexEmail `csv:"email"`
+	Active   *wrappers.WrapperBool    `csv:"active"`
+	Since    *wrappers.WrapperDate    `csv:"since"`
+	Score    *wrappers.WrapperFloat   `csv:"score" wrappers:"discard"`
+	Address  address                  `csv:"address"`
+	Internal *wrappers.WrapperString  `csv:"-"`
+}
+
+const input = "id,email,active,since,score,address.city,address.country,notes\n" +
+	"1,jane@example.com,yes,2024-03-01,4.5,Berlin,DE,first\n" +
+	"2,not-an-email,maybe,2024-02-30,high,Paris,FR,second\n" +
+	",john@example.com,no,,,,,third\n" +
+	"4,mia@example.com,1,2023-12-24,3,Vienna,AT,fourth\n"
+
+func TestDecoder(t *testing.T) {
+	t.Run("Skip", func(t *testing.T) {
+		decoder := NewDecoder[customer](strings.NewReader(input))
+		decoder.SetInvalidRowMode(InvalidRowSkip)
+
+		rows, err := decoder.DecodeAll()
+		if err != nil {
+			t.Fatalf("DecodeAll() error = %v", err)
+		}
+
+		if len(rows) != 4 {
+			t.Fatalf("DecodeAll() rows = %d, want 4", len(rows))
+		}
+
+		first := rows[0].Value
+		if !rows[0].Valid() || rows[0].Skipped {
+			t.Errorf("row 1 errors = %v, want valid", rows[0].Errors)
+		}
+		if first.ID.Get() != 1 || first.Email.Get() != "jane@example.com" || !first.Active.Get() || first.Since.Unwrap() != "2024-03-01" {
+			t.Errorf("row 1 = %v, %v, %v, %v", first.ID.Get(), first.Email.Get(), first.Active.Get(), first.Since.Unwrap())
+		}
+		if first.Score.Get() != 4.5 || first.Address.City.Get() != "Berlin" || first.Address.Country.Unwrap() != "Germany" {
+			t.Errorf("row 1 = %v, %v, %v", first.Score.Get(), first.Address.City.Get(), first.Address.Country.Unwrap())
+		}
+		if first.Internal != nil {
+			t.Errorf("Internal = %v, want nil", first.Internal)
+		}
+
+		// The score is tagged to be discarded, so it is not reported.
+		second := rows[1]
+		if !second.Skipped || second.Number != 2 || second.Line != 3 {
+			t.Errorf("row 2 Skipped = %v, Number = %d, Line = %d", second.Skipped, second.Number, second.Line)
+		}
+		assertColumns(t, second.Errors, "email", "active", "since")
+		if !second.Value.Score.IsDiscarded() {
+			t.Errorf("row 2 score should be discarded")
+		}
+
+		var validationError *wrappers.ValidationError
+		if !errors.As(second.Errors[0], &validationError) {
+			t.Errorf("errors.As(ValidationError) = false, want true")
+		}
+
+		// Empty cells discard their wrappers and are only reported for required columns.
+		third := rows[2]
+		assertColumns(t, third.Errors, "id")
+		if !errors.Is(third.Errors[0], ErrRequired) {
+			t.Errorf("row 3 error = %v, want ErrRequired", third.Errors[0])
+		}
+		if !third.Value.Since.IsDiscarded() || !third.Value.Address.City.IsDiscarded() {
+			t.Errorf("row 3 empty cells should be discarded")
+		}
+
+		if !rows[3].Valid() || rows[3].Value.Address.Country.Unwrap() != "Austria" {
+			t.Errorf("row 4 errors = %v", rows[3].Errors)
+		}
+	})
+
+	t.Run("Discard", func(t *testing.T) {
+		decoder := NewDecoder[customer](strings.NewReader(input))
+		decoder.SetInvalidRowMode(InvalidRowDiscard)
+
+		rows, err := decoder.DecodeAll()
+		if err != nil {
+			t.Fatalf("DecodeAll() error = %v", err)
+		}
+
+		second := rows[1]
+		if second.Skipped || second.Valid() {
+			t.Errorf("row 2 Skipped = %v, Valid = %v, want false, false", second.Skipped, second.Valid())
+		}
+		if !second.Value.Email.IsDiscarded() || second.Value.Address.City.Get() != "Paris" {
+			t.Errorf("row 2 should keep valid cells and discard invalid ones")
+		}
+	})
+
+	t.Run("Abort", func(t *testing.T) {
+		decoder := NewDecoder[customer](strings.NewReader(input))
+
+		rows, err := decoder.DecodeAll()
+
+		var rowError *RowError
+		if !errors.As(err, &rowError) || rowError.Row != 2 {
+			t.Fatalf("DecodeAll() error = %v, want RowError for row 2", err)
+		}
+
+		if len(rows) != 2 {
+			t.Errorf("DecodeAll() rows = %d, want 2", len(rows))
+		}
+
+		var cellError *CellError
+		if !errors.As(err, &cellError) || cellError.Column != "email" || cellError.Line != 3 {
+			t.Errorf("errors.As(CellError) = %v", cellError)
+		}
+
+		if _, err := decoder.Next(); err != rowError {
+			t.Errorf("Next() after abort error = %v, want %v", err, rowError)
+		}
+	})
+
+	t.Run("Next", func(t *testing.T) {
+		decoder := NewDecoder[customer](strings.NewReader("id;email\n1;jane@example.com\n"))
+		decoder.Reader().Comma = ';'
+
+		row, err := decoder.Next()
+		if err != nil {
+			t.Fatalf("Next() error = %v", err)
+		}
+
+		if row.Value.Email.Get() != "jane@example.com" || !row.Value.Active.IsDiscarded() {
+			t.Errorf("Next() = %v, %v", row.Value.Email.Get(), row.Value.Active.IsDiscarded())
+		}
+
+		if _, err := decoder.Next(); err != io.EOF {
+			t.Errorf("Next() error = %v, want io.EOF", err)
+		}
+	})
+
+	t.Run("Short row", func(t *testing.T) {
+		decoder := NewDecoder[customer](strings.NewReader("id,email,active\n5,bob@example.com\n\n6\n"))
+		decoder.Reader().FieldsPerRecord = -1
+		decoder.SetInvalidRowMode(InvalidRowSkip)
+
+		rows, err := decoder.DecodeAll()
+		if err != nil {
+			t.Fatalf("DecodeAll() error = %v", err)
+		}
+
+		if len(rows) != 2 || !rows[0].Valid() || !rows[0].Value.Active.IsDiscarded() || !rows[1].Value.Email.IsDiscarded() {
+			t.Errorf("DecodeAll() = %v, want missing cells to discard their wrappers", rows)
+		}
+
+		decoder = NewDecoder[customer](strings.NewReader("email,id\njane@example.com\n"))
+		decoder.Reader().FieldsPerRecord = -1
+
+		_, err = decoder.Next()
+
+		var cellError *CellError
+		if !errors.As(err, &cellError) || cellError.Column != "id" || !errors.Is(err, ErrRequired) || cellError.Line != 2 {
+			t.Errorf("Next() error = %v, want ErrRequired for the missing id cell", err)
+		}
+	})
+
+	t.Run("Header", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			input  string
+			strict bool
+		}{
+			{name: "Empty input", input: ""},
+			{name: "Duplicate column", input: "id,email,email\n"},
+			{name: "Missing required column", input: "email\n"},
+			{name: "Unknown column in strict mode", input: "id,notes\n", strict: true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				decoder := NewDecoder[customer](strings.NewReader(tt.input))
+				decoder.SetStrict(tt.strict)
+
+				if _, err := decoder.Next(); err == nil || err == io.EOF {
+					t.Errorf("Next() error = %v, want header error", err)
+				}
+			})
+		}
+	})
+
+	t.Run("Byte order mark", func(t *testing.T) {
+		decoder := NewDecoder[customer](strings.NewReader("\ufeffid\n7\n"))
+
+		row, err := decoder.Next()
+		if err != nil {
+			t.Fatalf("Next() error = %v", err)
+		}
+
+		if row.Value.ID.Get() != 7 {
+			t.Errorf("ID = %v, want 7", row.Value.ID.Get())
+		}
+	})
+}
+
+func TestEncoder(t *testing.T) {
+	decoder := NewDecoder[customer](strings.NewReader(input))
+	decoder.SetInvalidRowMode(InvalidRowDiscard)
+
+	rows, err := decoder.DecodeAll()
+	if err != nil {
+		t.Fatalf("DecodeAll() error = %v", err)
+	}
+
+	values := make([]customer, len(rows))
+	for i, row := range rows {
+		values[i] = row.Value
+	}
+
+	// Nil wrappers are written as empty cells just like discarded ones.
+	values = append(values, customer{ID: wrappers.NewWithValueDiscard[*wrappers.WrapperInt](int64(5))})
+
+	var output bytes.Buffer
+	if err := NewEncoder[customer](&output).EncodeAll(values); err != nil {
+		t.Fatalf("EncodeAll() error = %v", err)
+	}
+
+	want := "id,email,active,since,score,address.city,address.country\n" +
+		"1,jane@example.com,true,2024-03-01,4.5,Berlin,Germany\n" +
+		"2,,,,,Paris,France\n" +
+		",john@example.com,false,,,,\n" +
+		"4,mia@example.com,true,2023-12-24,3,Vienna,Austria\n" +
+		"5,,,,,,\n"
+
+	if output.String() != want {
+		t.Errorf("EncodeAll() = %q, want %q", output.String(), want)
+	}
+}
+
+func assertColumns(t *testing.T, errs []*CellError, columns ...string) {
+	t.Helper()
+
+	if len(errs) != len(columns) {
+		t.Fatalf("errors = %v, want columns %v", errs, columns)
+	}
+
+	for i, column := range columns {
+		if errs[i].Column != column {
+			t.Errorf("Column = %q, want %q", errs[i].Column, column)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package csv
+
+import (
+	stdcsv "encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/zealsprince/wrappers/internal/fields"
+)
+
+// Decoder reads CSV records into structs of wrappers. The first record is the header.
+type Decoder[T any] struct {
+	reader  *stdcsv.Reader
+	mode    InvalidRowMode
+	strict  bool           // If true, header columns without a field are rejected.
+	indices map[string]int // Column index by column name, read from the header.
+	number  int            // Number of the last data row.
+	err     error          // Sticky error returned once decoding failed or was aborted.
+}
+
+// NewDecoder creates a decoder reading from r. Rows with invalid cells abort decoding unless configured otherwise.
+func NewDecoder[T any](r io.Reader) *Decoder[T] {
+	return &Decoder[T]{reader: stdcsv.NewReader(r)}
+}
+
+// Reader returns the underlying CSV reader to configure e.g. the separator or comment character before decoding.
+func (decoder *Decoder[T]) Reader() *stdcsv.Reader {
+	return decoder.reader
+}
+
+// SetInvalidRowMode configures how rows with invalid cells are handled. Defaults to InvalidRowAbort.
+func (decoder *Decoder[T]) SetInvalidRowMode(mode InvalidRowMode) {
+	decoder.mode = mode
+}
+
+// SetStrict configures whether header columns without a matching field are rejected. By default they are ignored.
+func (decoder *Decoder[T]) SetStrict(strict bool) {
+	decoder.strict = strict
+}
+
+// header reads the header and maps its columns onto the fields of T.
+func (decoder *Decoder[T]) header() error {
+	record, err := decoder.reader.Read()
+	if err == io.EOF {
+		return fmt.Errorf("missing header")
+	}
+	if err != nil {
+		return err
+	}
+
+	var value T
+	names, err := columns(&value)
+	if err != nil {
+		return err
+	}
+
+	known := make(map[string]bool, len(names))
+	for _, name := range names {
+		known[name] = true
+	}
+
+	decoder.indices = make(map[string]int, len(record))
+	for i, column := range record {
+		column = strings.TrimSpace(column)
+		if i == 0 {
+			column = strings.TrimPrefix(column, "\ufeff") // Spreadsheet applications commonly prepend a byte order mark.
+		}
+
+		if _, ok := decoder.indices[column]; ok {
+			return fmt.Errorf("duplicate column %q", column)
+		}
+
+		if decoder.strict && !known[column] {
+			return fmt.Errorf("unknown column %q", column)
+		}
+
+		decoder.indices[column] = i
+	}
+
+	// Required columns have to be present in the header, otherwise every row would be invalid.
+	return fields.Walk(&value, options(false), func(field fields.Field) error {
+		if _, ok := decoder.indices[field.Name]; !ok && field.HasOption(TagRequired) {
+			return fmt.Errorf("missing required column %q", field.Name)
+		}
+
+		return nil
+	})
+}
+
+// Next decodes the next row. It returns io.EOF once all rows are decoded.
+// With InvalidRowAbort, an invalid row is returned together with a *RowError and decoding stops.
+// Columns missing from the header discard the wrappers of their fields, as do cells missing from short rows.
+func (decoder *Decoder[T]) Next() (*Row[T], error) {
+	if decoder.err != nil {
+		return nil, decoder.err
+	}
+
+	if decoder.indices == nil {
+		if err := decoder.header(); err != nil {
+			decoder.err = err
+			return nil, err
+		}
+	}
+
+	record, err := decoder.reader.Read()
+	if err != nil {
+		decoder.err = err
+		return nil, err
+	}
+
+	decoder.number++
+	line, _ := decoder.reader.FieldPos(0)
+
+	row := &Row[T]{Number: decoder.number, Line: line}
+
+	err = fields.Walk(&row.Value, options(true), func(field fields.Field) error {
+		wrapper := field.Wrapper()
+
+		index, ok := decoder.indices[field.Name]
+		if !ok {
+			wrapper.Discard()
+			return nil
+		}
+
+		// Rows may be shorter than the header if the reader allows a variable number of fields. Missing cells are treated as empty.
+		var content string
+		cellLine := line
+		if index < len(record) {
+			content = record[index]
+			cellLine, _ = decoder.reader.FieldPos(index)
+		}
+
+		if err := cell(wrapper, content, field.HasOption(TagRequired)); err != nil && !field.Discard {
+			row.Errors = append(row.Errors, &CellError{Row: row.Number, Line: cellLine, Column: field.Name, Err: err})
+		}
+
+		return nil
+	})
+	if err != nil {
+		decoder.err = err
+		return nil, err
+	}
+
+	if row.Valid() {
+		return row, nil
+	}
+
+	switch decoder.mode {
+	case InvalidRowSkip:
+		row.Skipped = true
+
+	case InvalidRowAbort:
+		decoder.err = &RowError{Row: row.Number, Errors: row.Errors}
+		return row, decoder.err
+	}
+
+	return row, nil
+}
+
+// DecodeAll decodes all remaining rows. With InvalidRowAbort, the rows decoded up to and including the invalid row are returned together with its *RowError.
+func (decoder *Decoder[T]) DecodeAll() ([]*Row[T], error) {
+	var rows []*Row[T]
+
+	for {
+		row, err := decoder.Next()
+		if row != nil {
+			rows = append(rows, row)
+		}
+
+		if err == io.EOF {
+			return rows, nil
+		}
+
+		if err != nil {
+			return rows, err
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package csv
+
+import (
+	stdcsv "encoding/csv"
+	"io"
+
+	"github.com/zealsprince/wrappers/internal/fields"
+)
+
+// Encoder writes structs of wrappers as CSV records. The header is written before the first record.
+type Encoder[T any] struct {
+	writer *stdcsv.Writer
+	header bool // Indicates if the header has been written.
+}
+
+// NewEncoder creates an encoder writing to w.
+func NewEncoder[T any](w io.Writer) *Encoder[T] {
+	return &Encoder[T]{writer: stdcsv.NewWriter(w)}
+}
+
+// Writer returns the underlying CSV writer to configure e.g. the separator before encoding.
+func (encoder *Encoder[T]) Writer() *stdcsv.Writer {
+	return encoder.writer
+}
+
+// WriteHeader writes the header unless it has already been written. It is called automatically by Encode.
+func (encoder *Encoder[T]) WriteHeader() error {
+	if encoder.header {
+		return nil
+	}
+
+	var value T
+	names, err := columns(&value)
+	if err != nil {
+		return err
+	}
+
+	encoder.header = true
+
+	return encoder.writer.Write(names)
+}
+
+// Encode writes the struct as a record. Nil and discarded wrappers are written as empty cells.
+func (encoder *Encoder[T]) Encode(value T) error {
+	if err := encoder.WriteHeader(); err != nil {
+		return err
+	}
+
+	var record []string
+
+	err := fields.Walk(&value, options(false), func(field fields.Field) error {
+		wrapper := field.Wrapper()
+		if wrapper == nil || wrapper.IsDiscarded() {
+			record = append(record, "")
+			return nil
+		}
+
+		text, err := fields.Text(wrapper)
+		if err != nil {
+			return err
+		}
+
+		record = append(record, string(text))
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return encoder.writer.Write(record)
+}
+
+// EncodeAll writes all structs and flushes the writer.
+func (encoder *Encoder[T]) EncodeAll(values []T) error {
+	for _, value := range values {
+		if err := encoder.Encode(value); err != nil {
+			return err
+		}
+	}
+
+	return encoder.Flush()
+}
+
+// Flush writes any buffered data and returns the error of the writer, if any.
+func (encoder *Encoder[T]) Flush() error {
+	encoder.writer.Flush()
+	return encoder.writer.Error()
+}
@@ -1,7 +1,9 @@
 package wrappers
 
 import (
+	"database/sql/driver"
 	"encoding/json"
+	"encoding/xml"
 	"reflect"
 )
 
@@ -35,6 +37,93 @@ func (discarder *Discarder[W]) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalText marshals the underlying wrapper to text. Discarded values are marshalled as empty text.
+func (discarder *Discarder[W]) MarshalText() ([]byte, error) {
+	if discarder.Proxy.IsDiscarded() {
+		return []byte{}, nil
+	}
+	return marshalText(discarder.Proxy)
+}
+
+// UnmarshalText unmarshals text into the underlying wrapper.
+// If unmarshalling fails, it discards the value without returning an error.
+func (discarder *Discarder[W]) UnmarshalText(data []byte) error {
+	err := unmarshalText(data, discarder.Proxy)
+	if err != nil {
+		// Check if our proxy is nil via reflection.
+		if reflect.ValueOf(discarder.Proxy).IsNil() {
+			return nil
+		}
+
+		discarder.Proxy.Discard()
+		return nil // Suppress the error by Discarder
+	}
+	return nil
+}
+
+// Scan scans a database value into the underlying wrapper.
+// If scanning fails, it discards the value without returning an error.
+func (discarder *Discarder[W]) Scan(src any) error {
+	err := scan(src, discarder.Proxy)
+	if err != nil {
+		// Check if our proxy is nil via reflection.
+		if reflect.ValueOf(discarder.Proxy).IsNil() {
+			return nil
+		}
+
+		discarder.Proxy.Discard()
+		return nil // Suppress the error by Discarder
+	}
+	return nil
+}
+
+// Value returns the driver value of the underlying wrapper (see driver.Valuer). Discarded values become SQL NULL.
+func (discarder *Discarder[W]) Value() (driver.Value, error) {
+	return DriverValue(discarder.Proxy)
+}
+
+// MarshalXML marshals the underlying wrapper to an XML element.
+func (discarder *Discarder[W]) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return marshalXML(encoder, start, discarder.Proxy)
+}
+
+// UnmarshalXML unmarshals an XML element into the underlying wrapper.
+// If unmarshalling fails, it discards the value without returning an error.
+func (discarder *Discarder[W]) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	err := unmarshalXML(decoder, start, discarder.Proxy)
+	if err != nil {
+		// Check if our proxy is nil via reflection.
+		if reflect.ValueOf(discarder.Proxy).IsNil() {
+			return nil
+		}
+
+		discarder.Proxy.Discard()
+		return nil // Suppress the error by Discarder
+	}
+	return nil
+}
+
+// MarshalXMLAttr marshals the underlying wrapper to an XML attribute.
+func (discarder *Discarder[W]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return marshalXMLAttr(name, discarder.Proxy)
+}
+
+// UnmarshalXMLAttr unmarshals an XML attribute into the underlying wrapper.
+// If unmarshalling fails, it discards the value without returning an error.
+func (discarder *Discarder[W]) UnmarshalXMLAttr(attr xml.Attr) error {
+	err := unmarshalXMLAttr(attr, discarder.Proxy)
+	if err != nil {
+		// Check if our proxy is nil via reflection.
+		if reflect.ValueOf(discarder.Proxy).IsNil() {
+			return nil
+		}
+
+		discarder.Proxy.Discard()
+		return nil // Suppress the error by Discarder
+	}
+	return nil
+}
+
 // NewDiscarder initializes a new Discarder wrapper with the provided underlying wrapper.
 func NewDiscarder[W WrapperProvider](wrapper W) *Discarder[W] {
 	Discarder := &Discarder[W]{
@@ -42,3 +131,20 @@ func NewDiscarder[W WrapperProvider](wrapper W) *Discarder[W] {
 	}
 	return Discarder
 }
+
+// Schema describes the proxied wrapper. Since invalid values are discarded and marshalled as null, the schema is nullable.
+func (discarder *Discarder[W]) Schema() Schema {
+	proxy := discarder.Proxy
+
+	// Describe a new wrapper if there is none yet, e.g. when generating a schema from a zero value.
+	value := reflect.ValueOf(proxy)
+	if value.Kind() == reflect.Pointer && value.IsNil() {
+		proxy = reflect.New(value.Type().Elem()).Interface().(W)
+		proxy.Initialize()
+	}
+
+	schema := SchemaOf(proxy)
+	schema.Nullable = true
+
+	return schema
+}
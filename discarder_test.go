@@ -250,3 +250,25 @@ func TestDiscarderInStruct(t *testing.T) {
 		})
 	}
 }
+
+// TestDiscarderText tests that the Discarder suppresses errors during text unmarshalling and marshals discarded values as empty text.
+func TestDiscarderText(t *testing.T) {
+	discarder := NewDiscarder(New[*WrapperInt]())
+
+	if err := discarder.UnmarshalText([]byte("abc")); err != nil {
+		t.Fatalf("UnmarshalText() error = %v, want nil", err)
+	}
+
+	if !discarder.Proxy.IsDiscarded() {
+		t.Errorf("IsDiscarded() = false, want true")
+	}
+
+	text, err := discarder.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText() error = %v", err)
+	}
+
+	if string(text) != "" {
+		t.Errorf("MarshalText() = %q, want empty text", string(text))
+	}
+}
@@ -1,6 +1,8 @@
 package enum
 
 import (
+	"encoding/xml"
+
 	"github.com/zealsprince/wrappers"
 )
 
@@ -37,3 +39,38 @@ func (wrapper *WrapperEnumCardinalDirections) UnmarshalJSON(data []byte) error {
 	}
 	return wrapper.WrapperEnum.UnmarshalJSON(data)
 }
+
+func (wrapper *WrapperEnumCardinalDirections) UnmarshalText(data []byte) error {
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+	return wrapper.WrapperEnum.UnmarshalText(data)
+}
+
+func (wrapper *WrapperEnumCardinalDirections) Scan(src any) error {
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+	return wrapper.WrapperEnum.Scan(src)
+}
+
+func (wrapper *WrapperEnumCardinalDirections) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+	return wrapper.WrapperEnum.UnmarshalXML(decoder, start)
+}
+
+func (wrapper *WrapperEnumCardinalDirections) UnmarshalXMLAttr(attr xml.Attr) error {
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+	return wrapper.WrapperEnum.UnmarshalXMLAttr(attr)
+}
+
+func (wrapper *WrapperEnumCardinalDirections) Set(value string) error {
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+	return wrapper.WrapperEnum.Set(value)
+}
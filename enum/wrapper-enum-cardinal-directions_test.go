@@ -3,6 +3,7 @@ package enum
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"testing"
 
 	"github.com/zealsprince/wrappers"
@@ -384,3 +385,123 @@ func TestWrapperEnum_CustomEnum(t *testing.T) {
 		})
 	}
 }
+
+// TestWrapperEnumCardinalDirections_Text tests text marshalling and unmarshalling of WrapperEnumCardinalDirections without prior initialization.
+func TestWrapperEnumCardinalDirections_Text(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		want        string
+		wantError   bool
+		wantDiscard bool
+	}{
+		{name: "Valid direction", input: "north", want: "north"},
+		{name: "Invalid direction", input: "up", wantError: true, wantDiscard: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapper := &WrapperEnumCardinalDirections{}
+
+			err := wrapper.UnmarshalText([]byte(tt.input))
+			if (err != nil) != tt.wantError {
+				t.Fatalf("UnmarshalText() error = %v, wantError %v", err, tt.wantError)
+			}
+
+			if wrapper.IsDiscarded() != tt.wantDiscard {
+				t.Errorf("IsDiscarded() = %v, want %v", wrapper.IsDiscarded(), tt.wantDiscard)
+			}
+
+			text, err := wrapper.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText() error = %v", err)
+			}
+
+			if string(text) != tt.want {
+				t.Errorf("MarshalText() = %q, want %q", string(text), tt.want)
+			}
+		})
+	}
+}
+
+// TestWrapperEnumCardinalDirections_Scan tests scanning database values into WrapperEnumCardinalDirections without prior initialization.
+func TestWrapperEnumCardinalDirections_Scan(t *testing.T) {
+	tests := []struct {
+		name        string
+		src         any
+		want        any
+		wantError   bool
+		wantDiscard bool
+	}{
+		{name: "Valid direction", src: "north", want: "north"},
+		{name: "Valid direction as bytes", src: []byte("east"), want: "east"},
+		{name: "Invalid direction", src: "up", wantError: true, wantDiscard: true},
+		{name: "NULL", src: nil, wantDiscard: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapper := &WrapperEnumCardinalDirections{}
+
+			err := wrapper.Scan(tt.src)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("Scan() error = %v, wantError %v", err, tt.wantError)
+			}
+
+			if wrapper.IsDiscarded() != tt.wantDiscard {
+				t.Errorf("IsDiscarded() = %v, want %v", wrapper.IsDiscarded(), tt.wantDiscard)
+			}
+
+			value, err := wrappers.DriverValue(wrapper)
+			if err != nil {
+				t.Fatalf("DriverValue() error = %v", err)
+			}
+
+			if value != tt.want {
+				t.Errorf("DriverValue() = %v, want %v", value, tt.want)
+			}
+		})
+	}
+}
+
+// TestWrapperEnumCardinalDirections_XML tests XML elements and attributes of WrapperEnumCardinalDirections without prior initialization.
+func TestWrapperEnumCardinalDirections_XML(t *testing.T) {
+	type Route struct {
+		XMLName xml.Name                       `xml:"route"`
+		Start   *WrapperEnumCardinalDirections `xml:"start,attr"`
+		End     *WrapperEnumCardinalDirections `xml:"end"`
+	}
+
+	tests := []struct {
+		name      string
+		input     string
+		wantError bool
+	}{
+		{name: "Valid directions", input: `<route start="north"><end>west</end></route>`},
+		{name: "Invalid attribute", input: `<route start="up"><end>west</end></route>`, wantError: true},
+		{name: "Invalid element", input: `<route start="north"><end>down</end></route>`, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var route Route
+			err := xml.Unmarshal([]byte(tt.input), &route)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("Unmarshal() error = %v, wantError %v", err, tt.wantError)
+			}
+
+			if tt.wantError {
+				return
+			}
+
+			output, err := xml.Marshal(&route)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+
+			if string(output) != tt.input {
+				t.Errorf("Marshal() = %s, want %s", output, tt.input)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package enum
 
 import (
+	"encoding/xml"
 	"fmt"
 
 	"github.com/zealsprince/wrappers"
@@ -112,3 +113,69 @@ func (wrapper *WrapperEnum[T]) UnmarshalJSON(data []byte) error {
 
 	return wrappers.UnmarshalJSON(data, wrapper)
 }
+
+func (wrapper *WrapperEnum[T]) MarshalText() ([]byte, error) {
+	return wrappers.MarshalText(wrapper)
+}
+
+func (wrapper *WrapperEnum[T]) UnmarshalText(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return wrappers.UnmarshalText(data, wrapper)
+}
+
+func (wrapper *WrapperEnum[T]) Scan(src any) error {
+	if wrapper == nil {
+		return fmt.Errorf("scan into nil wrapper")
+	}
+
+	return wrappers.Scan(src, wrapper)
+}
+
+func (wrapper *WrapperEnum[T]) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return wrappers.MarshalXML(encoder, start, wrapper)
+}
+
+func (wrapper *WrapperEnum[T]) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return wrappers.UnmarshalXML(decoder, start, wrapper)
+}
+
+func (wrapper *WrapperEnum[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return wrappers.MarshalXMLAttr(name, wrapper)
+}
+
+func (wrapper *WrapperEnum[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return wrappers.UnmarshalXMLAttr(attr, wrapper)
+}
+
+func (wrapper *WrapperEnum[T]) Set(value string) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return wrappers.Set(value, wrapper)
+}
+
+func (wrapper *WrapperEnum[T]) String() string {
+	return wrappers.String(wrapper)
+}
+
+// Schema describes the valid values of the wrapper.
+func (wrapper *WrapperEnum[T]) Schema() wrappers.Schema {
+	values := make([]any, len(wrapper.validValues))
+	for i, value := range wrapper.validValues {
+		values[i] = string(value)
+	}
+
+	return wrappers.Schema{Type: "string", Enum: values}
+}
@@ -0,0 +1,143 @@
+// Package env loads structs of wrappers from environment variables.
+package env
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/zealsprince/wrappers"
+	"github.com/zealsprince/wrappers/internal/fields"
+)
+
+const (
+	TagName     = "env"      // Struct tag holding the variable name. Untagged fields use their field name in upper snake case and "-" skips a field.
+	TagDefault  = "default"  // Struct tag holding the default value used when the variable is not set. Defaults are validated like any other value.
+	TagRequired = "required" // Option of the env tag, e.g. `env:"PORT,required"`, that reports variables without a value or default.
+	Separator   = ","        // Separator of the values of slices of wrappers.
+)
+
+// ErrRequired is reported for required variables without a value or default.
+var ErrRequired = errors.New("required variable is not set")
+
+// LookupFunc looks up the value of an environment variable. It has the signature of os.LookupEnv.
+type LookupFunc func(name string) (string, bool)
+
+// Loader loads structs of wrappers from environment variables.
+type Loader struct {
+	lookup LookupFunc // Defaults to os.LookupEnv.
+	prefix string     // Prefix of all variable names, e.g. "APP_".
+}
+
+// NewLoader creates a loader that reads variables with os.LookupEnv.
+func NewLoader() *Loader {
+	return &Loader{lookup: os.LookupEnv}
+}
+
+// SetLookup sets the function used to look up variables. This is useful to load from other sources or in tests.
+func (loader *Loader) SetLookup(lookup LookupFunc) {
+	loader.lookup = lookup
+}
+
+// SetPrefix sets a prefix prepended to all variable names, e.g. "APP_".
+func (loader *Loader) SetPrefix(prefix string) {
+	loader.prefix = prefix
+}
+
+// Load populates the wrapper fields of the struct pointed to by dst from environment variables. Nil wrappers are allocated.
+// Nested structs prefix the variables of their fields with their name, e.g. "DB_HOST". Empty variables are treated as not set.
+// Variables that are not set use the default of the field or discard its wrapper. Slices of wrappers are read from comma separated values.
+// Fields tagged with `wrappers:"discard"` discard invalid values instead of reporting them.
+// The returned ValidationErrors list every misconfigured variable.
+func (loader *Loader) Load(dst any) error {
+	lookup := loader.lookup
+	if lookup == nil {
+		lookup = os.LookupEnv
+	}
+
+	options := fields.Options{
+		Tag:      TagName,
+		Name:     fields.Snake,
+		Join:     func(prefix, name string) string { return prefix + "_" + name },
+		Allocate: true,
+	}
+
+	var validationErrors wrappers.ValidationErrors
+
+	err := fields.Walk(dst, options, func(field fields.Field) error {
+		name := loader.prefix + field.Name
+
+		value, ok := lookup(name)
+		if !ok || value == "" {
+			value, ok = field.Tag(TagDefault)
+		}
+
+		if !ok {
+			if field.HasOption(TagRequired) {
+				validationErrors = append(validationErrors, &wrappers.FieldError{Field: name, Err: ErrRequired})
+			}
+
+			if wrapper := field.Wrapper(); wrapper != nil {
+				wrapper.Discard()
+			}
+
+			return nil
+		}
+
+		if err := set(field, value); err != nil {
+			validationErrors = append(validationErrors, &wrappers.FieldError{Field: name, Err: err})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	return nil
+}
+
+// Load populates the struct pointed to by dst from the environment of the process. See Loader.Load.
+func Load(dst any) error {
+	return NewLoader().Load(dst)
+}
+
+// set wraps the value into the wrapper of the field or, for slices, into a new wrapper per comma separated element.
+func set(field fields.Field, value string) error {
+	if !field.IsSlice() {
+		wrapper := field.Wrapper()
+		if err := fields.Set(wrapper, value); err != nil {
+			wrapper.Discard()
+			if !field.Discard {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	elementType := field.StructField.Type.Elem()
+	slice := reflect.MakeSlice(field.StructField.Type, 0, 0)
+
+	var errs []error
+	for _, element := range strings.Split(value, Separator) {
+		elementValue, wrapper := fields.NewWrapper(elementType)
+		if err := fields.Set(wrapper, strings.TrimSpace(element)); err != nil {
+			if !field.Discard {
+				errs = append(errs, err)
+			}
+			continue // Invalid elements are left out of the slice.
+		}
+
+		slice = reflect.Append(slice, elementValue)
+	}
+
+	field.Value.Set(slice)
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,192 @@
+package env
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zealsprince/wrappers"
+	"github.com/zealsprince/wrappers/regex"
+)
+
+type database struct {
+	Host *wrappers.WrapperString `env:"HOST" default:"localhost"`
+	Port *wrappers.WrapperInt    `env:"PORT,required"`
+}
+
+type config struct {
+	Debug        *wrappers.WrapperBool         `env:"DEBUG"`
+	Timeout      *wrappers.WrapperTimeDuration `default:"30s"`
+	AdminEmail   *regex.WrapperRegexEmail
+	Timezone     wrappers.WrapperTimezone  `env:"TZ" default:"UTC"`
+	SampleRate   *wrappers.WrapperFloat    `wrappers:"discard"`
+	AllowedHosts []*wrappers.WrapperString `env:"ALLOWED_HOSTS"`
+	Database     database                  `env:"DB"`
+	Ignored      *wrappers.WrapperString   `env:"-"`
+}
+
+// lookup returns a LookupFunc that reads from the given map.
+func lookup(variables map[string]string) LookupFunc {
+	return func(name string) (string, bool) {
+		value, ok := variables[name]
+		return value, ok
+	}
+}
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name      string
+		prefix    string
+		variables map[string]string
+		check     func(t *testing.T, cfg *config)
+		wantError []string
+	}{
+		{
+			name: "Values",
+			variables: map[string]string{
+				"DEBUG":         "yes",
+				"TIMEOUT":       "PT5M",
+				"ADMIN_EMAIL":   "ops@example.com",
+				"TZ":            "Europe/Berlin",
+				"SAMPLE_RATE":   "0.25",
+				"ALLOWED_HOSTS": "example.com, example.org",
+				"DB_HOST":       "db.internal",
+				"DB_PORT":       "6543",
+				"IGNORED":       "value",
+			},
+			check: func(t *testing.T, cfg *config) {
+				if !cfg.Debug.Get() {
+					t.Errorf("Debug = false, want true")
+				}
+				if cfg.Timeout.Get() != 5*time.Minute {
+					t.Errorf("Timeout = %v, want 5m", cfg.Timeout.Get())
+				}
+				if cfg.AdminEmail.Get() != "ops@example.com" {
+					t.Errorf("AdminEmail = %v, want ops@example.com", cfg.AdminEmail.Get())
+				}
+				if cfg.Timezone.Unwrap() != "Europe/Berlin" {
+					t.Errorf("Timezone = %v, want Europe/Berlin", cfg.Timezone.Unwrap())
+				}
+				if cfg.SampleRate.Get() != 0.25 {
+					t.Errorf("SampleRate = %v, want 0.25", cfg.SampleRate.Get())
+				}
+				if len(cfg.AllowedHosts) != 2 || cfg.AllowedHosts[1].Get() != "example.org" {
+					t.Errorf("AllowedHosts = %v, want [example.com example.org]", cfg.AllowedHosts)
+				}
+				if cfg.Database.Host.Get() != "db.internal" || cfg.Database.Port.Get() != 6543 {
+					t.Errorf("Database = %v:%v, want db.internal:6543", cfg.Database.Host.Get(), cfg.Database.Port.Get())
+				}
+				if cfg.Ignored != nil {
+					t.Errorf("Ignored = %v, want nil", cfg.Ignored)
+				}
+			},
+		},
+		{
+			name:      "Defaults and missing values",
+			variables: map[string]string{"DB_PORT": "5432", "DEBUG": ""},
+			check: func(t *testing.T, cfg *config) {
+				if cfg.Timeout.Get() != 30*time.Second {
+					t.Errorf("Timeout = %v, want 30s", cfg.Timeout.Get())
+				}
+				if cfg.Timezone.Unwrap() != "UTC" {
+					t.Errorf("Timezone = %v, want UTC", cfg.Timezone.Unwrap())
+				}
+				if cfg.Database.Host.Get() != "localhost" {
+					t.Errorf("Database.Host = %v, want localhost", cfg.Database.Host.Get())
+				}
+				if !cfg.Debug.IsDiscarded() || !cfg.AdminEmail.IsDiscarded() {
+					t.Errorf("variables without value and default should be discarded")
+				}
+			},
+		},
+		{
+			name:      "Prefix",
+			prefix:    "APP_",
+			variables: map[string]string{"APP_DB_PORT": "5432", "DB_PORT": "invalid"},
+			check: func(t *testing.T, cfg *config) {
+				if cfg.Database.Port.Get() != 5432 {
+					t.Errorf("Database.Port = %v, want 5432", cfg.Database.Port.Get())
+				}
+			},
+		},
+		{
+			name:      "Discarded invalid value",
+			variables: map[string]string{"DB_PORT": "5432", "SAMPLE_RATE": "often"},
+			check: func(t *testing.T, cfg *config) {
+				if !cfg.SampleRate.IsDiscarded() {
+					t.Errorf("SampleRate should be discarded")
+				}
+			},
+		},
+		{
+			name: "Misconfigured variables",
+			variables: map[string]string{
+				"TIMEOUT":       "soon",
+				"ADMIN_EMAIL":   "ops",
+				"TZ":            "Mars/Olympus",
+				"ALLOWED_HOSTS": "example.com,42",
+			},
+			wantError: []string{"TIMEOUT", "ADMIN_EMAIL", "TZ", "DB_PORT"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loader := NewLoader()
+			loader.SetLookup(lookup(tt.variables))
+			loader.SetPrefix(tt.prefix)
+
+			var cfg config
+			err := loader.Load(&cfg)
+
+			if tt.wantError == nil {
+				if err != nil {
+					t.Fatalf("Load() error = %v", err)
+				}
+				tt.check(t, &cfg)
+				return
+			}
+
+			var validationErrors wrappers.ValidationErrors
+			if !errors.As(err, &validationErrors) {
+				t.Fatalf("Load() error = %v, want ValidationErrors", err)
+			}
+
+			if len(validationErrors) != len(tt.wantError) {
+				t.Fatalf("Load() errors = %v, want %v", validationErrors, tt.wantError)
+			}
+
+			for i, name := range tt.wantError {
+				if validationErrors[i].Field != name {
+					t.Errorf("Field = %v, want %v", validationErrors[i].Field, name)
+				}
+			}
+
+			if !errors.Is(err, ErrRequired) {
+				t.Errorf("errors.Is(ErrRequired) = false, want true")
+			}
+		})
+	}
+}
+
+func TestLoadProcessEnvironment(t *testing.T) {
+	t.Setenv("WRAPPERS_ENV_TEST_PORT", "8080")
+
+	var cfg struct {
+		Port *wrappers.WrapperInt `env:"WRAPPERS_ENV_TEST_PORT"`
+	}
+
+	if err := Load(&cfg); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	if cfg.Port.Get() != 8080 {
+		t.Errorf("Port = %v, want 8080", cfg.Port.Get())
+	}
+}
+
+func TestLoadNotAStructPointer(t *testing.T) {
+	if err := Load(config{}); err == nil {
+		t.Errorf("Load() error = nil, want error")
+	}
+}
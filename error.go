@@ -3,6 +3,7 @@ package wrappers
 import (
 	"fmt"
 	"reflect"
+	"strings"
 )
 
 // ValidationError represents an error during validation.
@@ -57,3 +58,39 @@ func ErrorParse(name Name, value any, err error) error {
 		Reason:      fmt.Sprintf("failed to parse: %v", err),
 	}
 }
+
+// FieldError associates an error, commonly a ValidationError, with the field it occurred on.
+// The field is named as seen by the input, e.g. a flag name, an environment variable or a form key.
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Field, e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationErrors aggregates the errors of multiple fields so that all invalid fields can be reported at once.
+type ValidationErrors []*FieldError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+
+	return errs
+}
@@ -0,0 +1,26 @@
+package wrappers
+
+import (
+	"reflect"
+)
+
+// Set is a generic implementation of the Set method of the flag.Value interface for wrappers. It is used to wrap a command-line value.
+// All wrappers should call this method in their Set implementation. The value is passed to Wrap as a string.
+func Set(value string, wrapper WrapperProvider) error {
+	return UnmarshalText([]byte(value), wrapper)
+}
+
+// String is a generic implementation of the String method of the flag.Value interface for wrappers. It returns the text representation of the wrapper.
+// All wrappers should call this method in their String implementation. Nil and discarded wrappers return an empty string.
+func String(wrapper WrapperProvider) string {
+	if reflect.ValueOf(wrapper).IsNil() {
+		return ""
+	}
+
+	text, err := MarshalText(wrapper)
+	if err != nil {
+		return ""
+	}
+
+	return string(text)
+}
@@ -0,0 +1,59 @@
+package wrappers
+
+import (
+	"flag"
+	"io"
+	"testing"
+)
+
+func TestFlagValue(t *testing.T) {
+	tests := []struct {
+		name      string
+		wrapper   standardWrapper
+		arguments []string
+		want      string
+		wantError bool
+	}{
+		{name: "WrapperInt", wrapper: &WrapperInt{}, arguments: []string{"-value", "42"}, want: "42"},
+		{name: "WrapperInt invalid", wrapper: &WrapperInt{}, arguments: []string{"-value", "forty"}, wantError: true},
+		{name: "WrapperBool without value", wrapper: &WrapperBool{}, arguments: []string{"-value"}, want: "true"},
+		{name: "WrapperBool with value", wrapper: &WrapperBool{}, arguments: []string{"-value=no"}, want: "false"},
+		{name: "WrapperTimeDuration", wrapper: &WrapperTimeDuration{}, arguments: []string{"-value", "PT1M"}, want: "1m0s"},
+		{name: "WrapperDate", wrapper: &WrapperDate{}, arguments: []string{"-value", "2024-03-01"}, want: "2024-03-01"},
+		{name: "WrapperDate invalid", wrapper: &WrapperDate{}, arguments: []string{"-value", "2024-02-30"}, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set := flag.NewFlagSet("test", flag.ContinueOnError)
+			set.SetOutput(io.Discard)
+			set.Var(tt.wrapper, "value", "")
+
+			err := set.Parse(tt.arguments)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("Parse() error = %v, wantError %v", err, tt.wantError)
+			}
+
+			if tt.wantError {
+				return
+			}
+
+			if tt.wrapper.String() != tt.want {
+				t.Errorf("String() = %q, want %q", tt.wrapper.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestFlagString(t *testing.T) {
+	var nilWrapper *WrapperInt
+	if nilWrapper.String() != "" {
+		t.Errorf("String() of nil wrapper = %q, want empty string", nilWrapper.String())
+	}
+
+	discarded := New[*WrapperString]()
+	discarded.Discard()
+	if discarded.String() != "" {
+		t.Errorf("String() of discarded wrapper = %q, want empty string", discarded.String())
+	}
+}
@@ -0,0 +1,157 @@
+// Package flags binds structs of wrappers to command-line flags of a flag.FlagSet.
+package flags
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/zealsprince/wrappers"
+	"github.com/zealsprince/wrappers/internal/fields"
+)
+
+const (
+	TagName    = "flag"    // Struct tag holding the flag name. Untagged fields use their field name in kebab case and "-" skips a field.
+	TagDefault = "default" // Struct tag holding the default value. Defaults are validated like any other value.
+	TagUsage   = "usage"   // Struct tag holding the usage text.
+)
+
+// Binding holds the flags bound to a struct and collects the errors of invalid flag values.
+type Binding struct {
+	set    *flag.FlagSet
+	values []*value
+	errors wrappers.ValidationErrors
+}
+
+// Bind registers a flag on the set for every wrapper field of the struct pointed to by dst. Nil wrappers are allocated.
+// Nested structs prefix the flags of their fields with their name, e.g. "db-host". Defaults are applied immediately.
+// Fields tagged with `wrappers:"discard"` discard invalid values instead of reporting them.
+func Bind(set *flag.FlagSet, dst any) (*Binding, error) {
+	binding := &Binding{set: set}
+
+	options := fields.Options{
+		Tag:      TagName,
+		Name:     fields.Kebab,
+		Join:     func(prefix, name string) string { return prefix + "-" + name },
+		Allocate: true,
+	}
+
+	err := fields.Walk(dst, options, func(field fields.Field) error {
+		if field.IsSlice() {
+			return fmt.Errorf("flag %q: slices of wrappers are not supported", field.Name)
+		}
+
+		if set.Lookup(field.Name) != nil {
+			return fmt.Errorf("flag %q: defined more than once", field.Name)
+		}
+
+		value := &value{
+			name:    field.Name,
+			wrapper: field.Wrapper(),
+			discard: field.Discard,
+			binding: binding,
+		}
+
+		if defaultValue, ok := field.Tag(TagDefault); ok {
+			if err := fields.Set(value.wrapper, defaultValue); err != nil {
+				value.wrapper.Discard()
+				if !value.discard {
+					return &wrappers.FieldError{Field: field.Name, Err: err}
+				}
+			}
+			value.defaulted = true
+		}
+
+		usage, _ := field.Tag(TagUsage)
+		set.Var(value, field.Name, usage)
+
+		binding.values = append(binding.values, value)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return binding, nil
+}
+
+// Parse parses the arguments and returns ValidationErrors listing every invalid flag value.
+// Parsing continues after invalid values so that all of them are reported at once, regardless of the error handling of the flag set.
+// Wrappers of flags that were neither set nor have a default are discarded.
+func (binding *Binding) Parse(arguments []string) error {
+	binding.errors = nil
+
+	if err := binding.set.Parse(arguments); err != nil {
+		return err
+	}
+
+	for _, value := range binding.values {
+		if !value.set && !value.defaulted {
+			value.wrapper.Discard()
+		}
+	}
+
+	if len(binding.errors) > 0 {
+		return binding.errors
+	}
+
+	return nil
+}
+
+// Parse binds the struct pointed to by dst to the set and parses the arguments. See Bind and Binding.Parse.
+func Parse(set *flag.FlagSet, dst any, arguments []string) error {
+	binding, err := Bind(set, dst)
+	if err != nil {
+		return err
+	}
+
+	return binding.Parse(arguments)
+}
+
+// value implements flag.Value for a wrapper and records invalid values on its binding.
+type value struct {
+	name      string
+	wrapper   wrappers.WrapperProvider
+	discard   bool
+	defaulted bool
+	set       bool
+	binding   *Binding
+}
+
+func (value *value) Set(text string) error {
+	value.set = true
+
+	if err := fields.Set(value.wrapper, text); err != nil {
+		value.wrapper.Discard()
+		if !value.discard {
+			value.binding.errors = append(value.binding.errors, &wrappers.FieldError{Field: value.name, Err: err})
+		}
+	}
+
+	return nil
+}
+
+// String returns the text of the wrapper. Flags without a value return an empty string, so that no default is printed in the usage.
+func (value *value) String() string {
+	if value == nil || value.wrapper == nil || (!value.set && !value.defaulted) {
+		return ""
+	}
+
+	text, err := fields.Text(value.wrapper)
+	if err != nil {
+		return ""
+	}
+
+	return string(text)
+}
+
+// IsBoolFlag allows boolean wrappers to be set without a value, e.g. "-verbose".
+func (value *value) IsBoolFlag() bool {
+	if value == nil {
+		return false
+	}
+
+	boolFlag, ok := value.wrapper.(interface{ IsBoolFlag() bool })
+
+	return ok && boolFlag.IsBoolFlag()
+}
@@ -0,0 +1,208 @@
+package flags
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zealsprince/wrappers"
+	"github.com/zealsprince/wrappers/regex"
+)
+
+type database struct {
+	Host *wrappers.WrapperString `flag:"host" default:"localhost" usage:"Database host"`
+	Port *wrappers.WrapperInt    `flag:"port" default:"5432" usage:"Database port"`
+}
+
+type config struct {
+	Verbose  *wrappers.WrapperBool         `flag:"verbose" usage:"Enable verbose output"`
+	Timeout  *wrappers.WrapperTimeDuration `default:"30s" usage:"Request timeout"`
+	Email    *regex.WrapperRegexEmail      `flag:"email" usage:"Contact email"`
+	Country  wrappers.WrapperCountry       `flag:"country" default:"DE"`
+	Ratio    *wrappers.WrapperFloat        `flag:"ratio" wrappers:"discard"`
+	Database database                      `flag:"db"`
+	Ignored  *wrappers.WrapperString       `flag:"-"`
+	internal *wrappers.WrapperString
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name      string
+		arguments []string
+		check     func(t *testing.T, cfg *config)
+		wantError []string
+	}{
+		{
+			name:      "Defaults",
+			arguments: []string{},
+			check: func(t *testing.T, cfg *config) {
+				if cfg.Timeout.Get() != 30*time.Second {
+					t.Errorf("Timeout = %v, want 30s", cfg.Timeout.Get())
+				}
+				if cfg.Database.Host.Get() != "localhost" || cfg.Database.Port.Get() != 5432 {
+					t.Errorf("Database = %v:%v, want localhost:5432", cfg.Database.Host.Get(), cfg.Database.Port.Get())
+				}
+				if cfg.Country.Unwrap() != "Germany" {
+					t.Errorf("Country = %v, want Germany", cfg.Country.Unwrap())
+				}
+				if !cfg.Verbose.IsDiscarded() || !cfg.Email.IsDiscarded() {
+					t.Errorf("flags without value and default should be discarded")
+				}
+			},
+		},
+		{
+			name:      "Values",
+			arguments: []string{"-verbose", "-timeout", "1m", "-email", "jane@example.com", "-db-host", "db.internal", "-db-port=6543", "-ratio", "0.5"},
+			check: func(t *testing.T, cfg *config) {
+				if !cfg.Verbose.Get() || cfg.Verbose.IsDiscarded() {
+					t.Errorf("Verbose = %v, want true", cfg.Verbose.Get())
+				}
+				if cfg.Timeout.Get() != time.Minute {
+					t.Errorf("Timeout = %v, want 1m", cfg.Timeout.Get())
+				}
+				if cfg.Email.Get() != "jane@example.com" {
+					t.Errorf("Email = %v, want jane@example.com", cfg.Email.Get())
+				}
+				if cfg.Database.Host.Get() != "db.internal" || cfg.Database.Port.Get() != 6543 {
+					t.Errorf("Database = %v:%v, want db.internal:6543", cfg.Database.Host.Get(), cfg.Database.Port.Get())
+				}
+				if cfg.Ratio.Get() != 0.5 {
+					t.Errorf("Ratio = %v, want 0.5", cfg.Ratio.Get())
+				}
+			},
+		},
+		{
+			name:      "Discarded invalid value",
+			arguments: []string{"-ratio", "half"},
+			check: func(t *testing.T, cfg *config) {
+				if !cfg.Ratio.IsDiscarded() {
+					t.Errorf("Ratio should be discarded")
+				}
+			},
+		},
+		{
+			name:      "Invalid values",
+			arguments: []string{"-email", "not-an-email", "-db-port", "http", "-timeout", "soon", "-verbose=maybe"},
+			wantError: []string{"email", "db-port", "timeout", "verbose"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cfg config
+			set := flag.NewFlagSet("test", flag.ContinueOnError)
+
+			err := Parse(set, &cfg, tt.arguments)
+
+			if tt.wantError == nil {
+				if err != nil {
+					t.Fatalf("Parse() error = %v", err)
+				}
+				tt.check(t, &cfg)
+				return
+			}
+
+			var validationErrors wrappers.ValidationErrors
+			if !errors.As(err, &validationErrors) {
+				t.Fatalf("Parse() error = %v, want ValidationErrors", err)
+			}
+
+			if len(validationErrors) != len(tt.wantError) {
+				t.Fatalf("Parse() errors = %v, want %v", validationErrors, tt.wantError)
+			}
+
+			for i, field := range tt.wantError {
+				if validationErrors[i].Field != field {
+					t.Errorf("Field = %v, want %v", validationErrors[i].Field, field)
+				}
+			}
+
+			var validationError *wrappers.ValidationError
+			if !errors.As(err, &validationError) {
+				t.Errorf("errors.As(ValidationError) = false, want true")
+			}
+		})
+	}
+}
+
+func TestBind(t *testing.T) {
+	t.Run("Usage", func(t *testing.T) {
+		var cfg config
+		set := flag.NewFlagSet("test", flag.ContinueOnError)
+
+		if _, err := Bind(set, &cfg); err != nil {
+			t.Fatalf("Bind() error = %v", err)
+		}
+
+		var output bytes.Buffer
+		set.SetOutput(&output)
+		set.PrintDefaults()
+
+		for _, want := range []string{"-db-host", "Database host (default localhost)", "-timeout", "(default 30s)", "-verbose", "Enable verbose output"} {
+			if !strings.Contains(output.String(), want) {
+				t.Errorf("PrintDefaults() = %s, want %q", output.String(), want)
+			}
+		}
+
+		for _, unwanted := range []string{"-ignored", "-internal", "-email value\n    \tContact email (default"} {
+			if strings.Contains(output.String(), unwanted) {
+				t.Errorf("PrintDefaults() = %s, should not contain %q", output.String(), unwanted)
+			}
+		}
+	})
+
+	t.Run("Invalid default", func(t *testing.T) {
+		var cfg struct {
+			Port *wrappers.WrapperInt `default:"http"`
+		}
+
+		var fieldError *wrappers.FieldError
+		if _, err := Bind(flag.NewFlagSet("test", flag.ContinueOnError), &cfg); !errors.As(err, &fieldError) || fieldError.Field != "port" {
+			t.Errorf("Bind() error = %v, want FieldError for port", err)
+		}
+	})
+
+	t.Run("Invalid discarded default replaced by a flag", func(t *testing.T) {
+		var cfg struct {
+			Port *wrappers.WrapperInt `default:"http" wrappers:"discard"`
+		}
+
+		set := flag.NewFlagSet("test", flag.ContinueOnError)
+		binding, err := Bind(set, &cfg)
+		if err != nil {
+			t.Fatalf("Bind() error = %v", err)
+		}
+
+		if !cfg.Port.IsDiscarded() {
+			t.Errorf("IsDiscarded() = false after an invalid default, want true")
+		}
+
+		if err := binding.Parse([]string{"-port", "8080"}); err != nil {
+			t.Fatalf("Parse() error = %v", err)
+		}
+
+		if cfg.Port.IsDiscarded() || cfg.Port.Get() != 8080 {
+			t.Errorf("Port = %v (discarded %v), want 8080", cfg.Port.Get(), cfg.Port.IsDiscarded())
+		}
+	})
+
+	t.Run("Duplicate flag", func(t *testing.T) {
+		var cfg struct {
+			A *wrappers.WrapperInt `flag:"value"`
+			B *wrappers.WrapperInt `flag:"value"`
+		}
+
+		if _, err := Bind(flag.NewFlagSet("test", flag.ContinueOnError), &cfg); err == nil {
+			t.Errorf("Bind() error = nil, want error")
+		}
+	})
+
+	t.Run("Not a struct pointer", func(t *testing.T) {
+		if _, err := Bind(flag.NewFlagSet("test", flag.ContinueOnError), config{}); err == nil {
+			t.Errorf("Bind() error = nil, want error")
+		}
+	})
+}
@@ -0,0 +1,157 @@
+// Package form decodes URL query strings and form posts into structs of wrappers and encodes them back.
+package form
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+
+	"github.com/zealsprince/wrappers"
+	"github.com/zealsprince/wrappers/internal/fields"
+)
+
+const (
+	TagName = "form" // Struct tag holding the key. Untagged fields use their field name and "-" skips a field.
+)
+
+// options returns the options used to walk structs of wrappers. Nested structs use bracket notation, e.g. "address[city]".
+func options(allocate bool) fields.Options {
+	return fields.Options{
+		Tag:      TagName,
+		Join:     func(prefix, name string) string { return prefix + "[" + name + "]" },
+		Allocate: allocate,
+	}
+}
+
+// Decode populates the wrapper fields of the struct pointed to by dst from the values. Nil wrappers are allocated.
+// Nested structs are addressed with bracket notation, e.g. "address[city]". Slices of wrappers are populated from repeated keys,
+// which may also carry a "[]" suffix, e.g. "tag=a&tag=b" or "tag[]=a&tag[]=b". For other fields only the first value is used.
+// Missing keys and empty values discard the wrapper. Fields tagged with `wrappers:"discard"` discard invalid values instead of reporting them.
+// The returned ValidationErrors list every invalid key. Elements of slices are reported with their index, e.g. "tag[1]".
+func Decode(values url.Values, dst any) error {
+	var validationErrors wrappers.ValidationErrors
+
+	err := fields.Walk(dst, options(true), func(field fields.Field) error {
+		if field.IsSlice() {
+			validationErrors = append(validationErrors, decodeSlice(field, values)...)
+			return nil
+		}
+
+		wrapper := field.Wrapper()
+
+		value := values.Get(field.Name)
+		if value == "" {
+			wrapper.Discard()
+			return nil
+		}
+
+		if err := fields.Set(wrapper, value); err != nil {
+			wrapper.Discard()
+			if !field.Discard {
+				validationErrors = append(validationErrors, &wrappers.FieldError{Field: field.Name, Err: err})
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(validationErrors) > 0 {
+		return validationErrors
+	}
+
+	return nil
+}
+
+// decodeSlice populates a slice of wrappers from the repeated values of its key. Empty and invalid values are left out of the slice.
+func decodeSlice(field fields.Field, values url.Values) wrappers.ValidationErrors {
+	elements := append(values[field.Name], values[field.Name+"[]"]...)
+	if len(elements) == 0 {
+		return nil
+	}
+
+	var validationErrors wrappers.ValidationErrors
+
+	slice := reflect.MakeSlice(field.StructField.Type, 0, len(elements))
+	for i, element := range elements {
+		if element == "" {
+			continue
+		}
+
+		elementValue, wrapper := fields.NewWrapper(field.StructField.Type.Elem())
+		if err := fields.Set(wrapper, element); err != nil {
+			if !field.Discard {
+				validationErrors = append(validationErrors, &wrappers.FieldError{Field: fmt.Sprintf("%s[%d]", field.Name, i), Err: err})
+			}
+			continue
+		}
+
+		slice = reflect.Append(slice, elementValue)
+	}
+
+	field.Value.Set(slice)
+
+	return validationErrors
+}
+
+// Encode converts the wrapper fields of the struct, or pointer to a struct, into values. Nil and discarded wrappers are left out.
+// Nested structs use bracket notation and slices of wrappers are encoded as repeated keys.
+func Encode(src any) (url.Values, error) {
+	value := reflect.ValueOf(src)
+	if value.Kind() == reflect.Struct {
+		// Walking requires a pointer, so the struct is copied into an addressable value.
+		pointer := reflect.New(value.Type())
+		pointer.Elem().Set(value)
+		src = pointer.Interface()
+	}
+
+	values := url.Values{}
+
+	err := fields.Walk(src, options(false), func(field fields.Field) error {
+		if !field.IsSlice() {
+			return encode(values, field.Name, field.Wrapper())
+		}
+
+		if !field.Value.IsValid() {
+			return nil
+		}
+
+		for i := 0; i < field.Value.Len(); i++ {
+			element := field.Value.Index(i)
+			if element.Kind() != reflect.Pointer {
+				element = element.Addr()
+			} else if element.IsNil() {
+				continue
+			}
+
+			if err := encode(values, field.Name, element.Interface().(wrappers.WrapperProvider)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// encode adds the text of the wrapper to the values unless it is nil or discarded.
+func encode(values url.Values, key string, wrapper wrappers.WrapperProvider) error {
+	if wrapper == nil || wrapper.IsDiscarded() {
+		return nil
+	}
+
+	text, err := fields.Text(wrapper)
+	if err != nil {
+		return err
+	}
+
+	values.Add(key, string(text))
+
+	return nil
+}
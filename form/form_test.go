@@ -0,0 +1,173 @@
+package form
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/zealsprince/wrappers"
+	"github.com/zealsprince/wrappers/enum"
+	"github.com/zealsprince/wrappers/regex"
+)
+
+type bounds struct {
+	From *wrappers.WrapperDate `form:"from"`
+	To   *wrappers.WrapperDate `form:"to"`
+}
+
+type search struct {
+	Query     *wrappers.WrapperString             `form:"q"`
+	Page      *wrappers.WrapperInt                `form:"page"`
+	Exact     *wrappers.WrapperBool               `form:"exact"`
+	Email     *regex.WrapperRegexEmail            `form:"email"`
+	Direction *enum.WrapperEnumCardinalDirections `form:"direction"`
+	Countries []*wrappers.WrapperCountry          `form:"country"`
+	Tags      []wrappers.WrapperString            `form:"tag"`
+	Radius    *wrappers.WrapperFloat              `form:"radius" wrappers:"discard"`
+	Period    bounds                              `form:"period"`
+	Nested    struct {
+		Deep bounds `form:"deep"`
+	} `form:"nested"`
+	Ignored *wrappers.WrapperString `form:"-"`
+}
+
+func TestDecode(t *testing.T) {
+	t.Run("Values", func(t *testing.T) {
+		values, _ := url.ParseQuery("q=shoes&page=2&exact=yes&email=jane%40example.com&direction=north&country=DE&country=FR&tag[]=sale&tag[]=new" +
+			"&radius=far&period[from]=2024-03-01&period[to]=2024-03-31&nested[deep][from]=2024-01-01&Ignored=x")
+
+		var dst search
+		if err := Decode(values, &dst); err != nil {
+			t.Fatalf("Decode() error = %v", err)
+		}
+
+		if dst.Query.Get() != "shoes" || dst.Page.Get() != 2 || !dst.Exact.Get() || dst.Email.Get() != "jane@example.com" {
+			t.Errorf("Decode() = %v, %v, %v, %v", dst.Query.Get(), dst.Page.Get(), dst.Exact.Get(), dst.Email.Get())
+		}
+
+		if dst.Direction.Unwrap() != "north" {
+			t.Errorf("Direction = %v, want north", dst.Direction.Unwrap())
+		}
+
+		if len(dst.Countries) != 2 || dst.Countries[1].Unwrap() != "France" {
+			t.Errorf("Countries = %v, want [Germany France]", dst.Countries)
+		}
+
+		if len(dst.Tags) != 2 || dst.Tags[0].Get() != "sale" || dst.Tags[1].Get() != "new" {
+			t.Errorf("Tags = %v, want [sale new]", dst.Tags)
+		}
+
+		if !dst.Radius.IsDiscarded() {
+			t.Errorf("Radius should be discarded")
+		}
+
+		if dst.Period.From.Unwrap() != "2024-03-01" || dst.Period.To.Unwrap() != "2024-03-31" {
+			t.Errorf("Period = %v - %v", dst.Period.From.Unwrap(), dst.Period.To.Unwrap())
+		}
+
+		if dst.Nested.Deep.From.Unwrap() != "2024-01-01" || !dst.Nested.Deep.To.IsDiscarded() {
+			t.Errorf("Nested = %v - %v", dst.Nested.Deep.From.Unwrap(), dst.Nested.Deep.To.Unwrap())
+		}
+
+		if dst.Ignored != nil {
+			t.Errorf("Ignored = %v, want nil", dst.Ignored)
+		}
+	})
+
+	t.Run("Missing and empty values", func(t *testing.T) {
+		values, _ := url.ParseQuery("q=&page=1")
+
+		var dst search
+		if err := Decode(values, &dst); err != nil {
+			t.Fatalf("Decode() error = %v", err)
+		}
+
+		if !dst.Query.IsDiscarded() || !dst.Email.IsDiscarded() || dst.Countries != nil {
+			t.Errorf("missing and empty values should be discarded")
+		}
+	})
+
+	t.Run("Invalid values", func(t *testing.T) {
+		values, _ := url.ParseQuery("page=two&email=jane&direction=up&country=DE&country=Atlantis&period[from]=2024-02-30")
+
+		var dst search
+		err := Decode(values, &dst)
+
+		var validationErrors wrappers.ValidationErrors
+		if !errors.As(err, &validationErrors) {
+			t.Fatalf("Decode() error = %v, want ValidationErrors", err)
+		}
+
+		want := []string{"page", "email", "direction", "country[1]", "period[from]"}
+		if len(validationErrors) != len(want) {
+			t.Fatalf("Decode() errors = %v, want %v", validationErrors, want)
+		}
+
+		for i, field := range want {
+			if validationErrors[i].Field != field {
+				t.Errorf("Field = %q, want %q", validationErrors[i].Field, field)
+			}
+		}
+
+		if len(dst.Countries) != 1 {
+			t.Errorf("Countries = %v, want only the valid country", dst.Countries)
+		}
+	})
+
+	t.Run("Not a struct pointer", func(t *testing.T) {
+		if err := Decode(url.Values{}, search{}); err == nil {
+			t.Errorf("Decode() error = nil, want error")
+		}
+	})
+}
+
+func TestEncode(t *testing.T) {
+	query := "q=shoes&page=2&exact=yes&direction=north&country=DE&country=FR&tag=sale&period[from]=2024-03-01"
+
+	values, _ := url.ParseQuery(query)
+
+	var dst search
+	if err := Decode(values, &dst); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+
+	encoded, err := Encode(dst)
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+
+	want := url.Values{
+		"q":            {"shoes"},
+		"page":         {"2"},
+		"exact":        {"true"},
+		"direction":    {"north"},
+		"country":      {"Germany", "France"},
+		"tag":          {"sale"},
+		"period[from]": {"2024-03-01"},
+	}
+
+	if encoded.Encode() != want.Encode() {
+		t.Errorf("Encode() = %v, want %v", encoded.Encode(), want.Encode())
+	}
+
+	// Encoding the result again decodes into the same values.
+	var again search
+	if err := Decode(encoded, &again); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+
+	if again.Countries[0].Unwrap() != "Germany" || again.Period.From.Unwrap() != "2024-03-01" {
+		t.Errorf("Decode() = %v, %v", again.Countries[0].Unwrap(), again.Period.From.Unwrap())
+	}
+
+	t.Run("Pointer with nil fields", func(t *testing.T) {
+		encoded, err := Encode(&search{Page: wrappers.NewWithValueDiscard[*wrappers.WrapperInt](int64(3))})
+		if err != nil {
+			t.Fatalf("Encode() error = %v", err)
+		}
+
+		if encoded.Encode() != "page=3" {
+			t.Errorf("Encode() = %v, want page=3", encoded.Encode())
+		}
+	})
+}
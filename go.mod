@@ -3,3 +3,8 @@ module github.com/zealsprince/wrappers
 go 1.23.4
 
 require github.com/biter777/countries v1.7.5
+
+require (
+	golang.org/x/net v0.34.0
+	golang.org/x/text v0.21.0 // indirect
+)
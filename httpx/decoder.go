@@ -0,0 +1,441 @@
+package httpx
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/zealsprince/wrappers"
+	"github.com/zealsprince/wrappers/internal/fields"
+)
+
+var (
+	unmarshalerType     = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// Decoder decodes JSON request bodies into values of T, commonly a struct of wrappers.
+type Decoder[T any] struct {
+	maxBodySize int64 // Limit of request bodies in bytes.
+}
+
+// NewDecoder creates a decoder limiting request bodies to DefaultMaxBodySize.
+func NewDecoder[T any]() *Decoder[T] {
+	return &Decoder[T]{maxBodySize: DefaultMaxBodySize}
+}
+
+// SetMaxBodySize sets the limit of request bodies in bytes. Defaults to DefaultMaxBodySize.
+func (decoder *Decoder[T]) SetMaxBodySize(size int64) {
+	decoder.maxBodySize = size
+}
+
+// Decode decodes the JSON body of the request using a default decoder.
+func Decode[T any](r *http.Request) (T, error) {
+	return NewDecoder[T]().Decode(r)
+}
+
+// Decode decodes the JSON body of the request into a value of T.
+// Requests without a JSON content type fail with ErrUnsupportedMediaType, bodies exceeding the limit with ErrBodyTooLarge
+// and empty or invalid JSON with ErrMalformedBody.
+// Unlike encoding/json, which stops at the first invalid wrapper, every wrapper is validated, including those of structs in slices and maps.
+// The returned ValidationErrors name each invalid field by its JSON pointer, e.g. "/address/city", "/tags/1" or "/items/0/email".
+// Invalid wrappers of fields tagged with `wrappers:"discard"` are discarded instead of failing the request.
+func (decoder *Decoder[T]) Decode(r *http.Request) (T, error) {
+	var value T
+
+	if err := contentType(r); err != nil {
+		return value, err
+	}
+
+	data, err := decoder.body(r)
+	if err != nil {
+		return value, err
+	}
+
+	if err := decode(reflect.ValueOf(&value).Elem(), data); err != nil {
+		return value, err
+	}
+
+	return value, nil
+}
+
+// contentType checks that the request declares a JSON content type.
+func contentType(r *http.Request) error {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return fmt.Errorf("%w: expected %s", ErrUnsupportedMediaType, ContentTypeJSON)
+	}
+
+	if mediaType != ContentTypeJSON && !(strings.HasPrefix(mediaType, "application/") && strings.HasSuffix(mediaType, "+json")) {
+		return fmt.Errorf("%w: expected %s, got %s", ErrUnsupportedMediaType, ContentTypeJSON, mediaType)
+	}
+
+	return nil
+}
+
+// body reads the request body up to the limit of the decoder.
+func (decoder *Decoder[T]) body(r *http.Request) ([]byte, error) {
+	if r.Body == nil {
+		return nil, fmt.Errorf("%w: empty body", ErrMalformedBody)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(r.Body, decoder.maxBodySize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(data)) > decoder.maxBodySize {
+		return nil, fmt.Errorf("%w: limit is %d bytes", ErrBodyTooLarge, decoder.maxBodySize)
+	}
+
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, fmt.Errorf("%w: empty body", ErrMalformedBody)
+	}
+
+	if !json.Valid(data) {
+		var v any
+		return nil, fmt.Errorf("%w: %v", ErrMalformedBody, json.Unmarshal(data, &v))
+	}
+
+	return data, nil
+}
+
+// decoding collects the errors of all invalid members while decoding a body.
+type decoding struct {
+	errors    wrappers.ValidationErrors
+	malformed error // Error of the body as a whole, e.g. an array where an object is expected.
+}
+
+// decode decodes the data into the value. Wrappers are unmarshalled individually to collect the errors of all invalid members,
+// while values that do not contain wrappers are left to encoding/json.
+func decode(value reflect.Value, data []byte) error {
+	var d decoding
+	d.decode(value, data, nil, false)
+
+	if d.malformed != nil {
+		return fmt.Errorf("%w: %v", ErrMalformedBody, d.malformed)
+	}
+
+	if len(d.errors) > 0 {
+		return d.errors
+	}
+
+	return nil
+}
+
+// decode decodes the raw member at the path of segments into the value.
+// If discard is set, invalid wrappers are discarded instead of reported.
+func (d *decoding) decode(value reflect.Value, raw json.RawMessage, segments []string, discard bool) {
+	t := value.Type()
+
+	if fields.IsWrapper(t) {
+		d.wrapper(value, raw, segments, discard)
+		return
+	}
+
+	if !containsWrapper(t, map[reflect.Type]bool{}) {
+		d.unmarshal(value, raw, segments)
+		return
+	}
+
+	null := isNull(raw)
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		if null {
+			value.SetZero()
+			return
+		}
+
+		if value.IsNil() {
+			value.Set(reflect.New(t.Elem()))
+		}
+
+		d.decode(value.Elem(), raw, segments, discard)
+
+	case reflect.Struct:
+		if null {
+			return
+		}
+
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &object); err != nil {
+			d.fail(segments, &json.UnmarshalTypeError{Value: kindOf(raw), Type: t})
+			return
+		}
+
+		d.object(value, object, segments)
+
+	case reflect.Slice, reflect.Array:
+		if null {
+			if t.Kind() == reflect.Slice {
+				value.SetZero()
+			}
+			return
+		}
+
+		var elements []json.RawMessage
+		if err := json.Unmarshal(raw, &elements); err != nil {
+			d.fail(segments, &json.UnmarshalTypeError{Value: kindOf(raw), Type: t})
+			return
+		}
+
+		if t.Kind() == reflect.Slice {
+			value.Set(reflect.MakeSlice(t, len(elements), len(elements)))
+		}
+
+		for i := 0; i < value.Len(); i++ {
+			if i >= len(elements) {
+				value.Index(i).SetZero()
+				continue
+			}
+
+			d.decode(value.Index(i), elements[i], append(segments[:len(segments):len(segments)], strconv.Itoa(i)), discard)
+		}
+
+	case reflect.Map:
+		if null {
+			value.SetZero()
+			return
+		}
+
+		if t.Key().Kind() != reflect.String || reflect.PointerTo(t.Key()).Implements(textUnmarshalerType) {
+			d.unmarshal(value, raw, segments)
+			return
+		}
+
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &object); err != nil {
+			d.fail(segments, &json.UnmarshalTypeError{Value: kindOf(raw), Type: t})
+			return
+		}
+
+		if value.IsNil() {
+			value.Set(reflect.MakeMapWithSize(t, len(object)))
+		}
+
+		// Keys are sorted so that errors are reported in a stable order.
+		keys := make([]string, 0, len(object))
+		for key := range object {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			element := reflect.New(t.Elem()).Elem()
+			d.decode(element, object[key], append(segments[:len(segments):len(segments)], key), discard)
+			value.SetMapIndex(reflect.ValueOf(key).Convert(t.Key()), element)
+		}
+
+	default:
+		d.unmarshal(value, raw, segments)
+	}
+}
+
+// object decodes the members of the object into the fields of the struct value.
+// Like encoding/json, names are read from the json tag and the fields of embedded structs without a name are promoted.
+func (d *decoding) object(value reflect.Value, object map[string]json.RawMessage, segments []string) {
+	t := value.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+
+		tag := structField.Tag.Get(TagName)
+		if tag == "-" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+		field := value.Field(i)
+
+		if structField.Anonymous && name == "" && !fields.IsWrapper(structField.Type) {
+			embedded := structField.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+
+			if embedded.Kind() == reflect.Struct {
+				if structField.Type.Kind() == reflect.Pointer {
+					if !structField.IsExported() {
+						continue
+					}
+
+					if field.IsNil() {
+						field.Set(reflect.New(embedded))
+					}
+					field = field.Elem()
+				}
+
+				d.object(field, object, segments)
+				continue
+			}
+		}
+
+		if !structField.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = structField.Name
+		}
+
+		raw, ok := member(object, name)
+		if !ok {
+			continue
+		}
+
+		path := append(segments[:len(segments):len(segments)], name)
+
+		if fields.HasOption(tag, "string") && !containsWrapper(structField.Type, map[reflect.Type]bool{}) {
+			d.quoted(field, structField.Type, raw, path)
+			continue
+		}
+
+		wrappersTag, _ := structField.Tag.Lookup(wrappers.WrappersTagHeader)
+		d.decode(field, raw, path, slices.Contains(strings.Split(wrappersTag, ","), wrappers.WrappersTagDiscard))
+	}
+}
+
+// wrapper unmarshals the raw member into a new wrapper stored in the value. Null members of pointers to wrappers are left nil.
+func (d *decoding) wrapper(value reflect.Value, raw json.RawMessage, segments []string, discard bool) {
+	if value.Kind() == reflect.Pointer && isNull(raw) {
+		value.SetZero()
+		return
+	}
+
+	element, wrapper := fields.NewWrapper(value.Type())
+	err := wrapper.UnmarshalJSON(raw)
+	if err != nil {
+		if !discard {
+			d.fail(segments, err)
+		}
+		wrapper.Discard()
+	}
+
+	value.Set(element)
+}
+
+// unmarshal decodes the raw member into the value with encoding/json.
+func (d *decoding) unmarshal(value reflect.Value, raw json.RawMessage, segments []string) {
+	if err := json.Unmarshal(raw, value.Addr().Interface()); err != nil {
+		var typeError *json.UnmarshalTypeError
+		if errors.As(err, &typeError) && typeError.Field != "" {
+			segments = append(segments[:len(segments):len(segments)], strings.Split(typeError.Field, ".")...)
+		}
+
+		d.fail(segments, err)
+	}
+}
+
+// quoted decodes the raw member into the value of a field with the string option, i.e. a JSON string holding a number or boolean.
+func (d *decoding) quoted(value reflect.Value, t reflect.Type, raw json.RawMessage, segments []string) {
+	holder := reflect.New(reflect.StructOf([]reflect.StructField{{Name: "Value", Type: t, Tag: `json:"value,string"`}}))
+
+	data, err := json.Marshal(map[string]json.RawMessage{"value": raw})
+	if err == nil {
+		err = json.Unmarshal(data, holder.Interface())
+	}
+
+	if err != nil {
+		d.fail(segments, err)
+		return
+	}
+
+	value.Set(holder.Elem().Field(0))
+}
+
+// fail records the error of the member at the path of segments. Errors of the body as a whole make it malformed.
+func (d *decoding) fail(segments []string, err error) {
+	if len(segments) == 0 {
+		if d.malformed == nil {
+			d.malformed = err
+		}
+		return
+	}
+
+	d.errors = append(d.errors, &wrappers.FieldError{Field: pointer(segments...), Err: err})
+}
+
+// containsWrapper checks if values of the type hold wrappers that are decoded individually. Types with their own UnmarshalJSON
+// or UnmarshalText method, such as Discarder, are decoded by encoding/json as a whole.
+func containsWrapper(t reflect.Type, seen map[reflect.Type]bool) bool {
+	if fields.IsWrapper(t) {
+		return true
+	}
+
+	if reflect.PointerTo(t).Implements(unmarshalerType) || reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		return false
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+		return containsWrapper(t.Elem(), seen)
+
+	case reflect.Struct:
+		if seen[t] {
+			return false
+		}
+		seen[t] = true
+
+		for i := 0; i < t.NumField(); i++ {
+			structField := t.Field(i)
+			if (structField.IsExported() || structField.Anonymous) && containsWrapper(structField.Type, seen) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// member returns the member of the object with the given name. Like encoding/json, names are matched case-insensitively if there is no exact match.
+func member(object map[string]json.RawMessage, name string) (json.RawMessage, bool) {
+	if raw, ok := object[name]; ok {
+		return raw, true
+	}
+
+	for key, raw := range object {
+		if strings.EqualFold(key, name) {
+			return raw, true
+		}
+	}
+
+	return nil, false
+}
+
+// kindOf describes the JSON kind of the raw member in the terms of json.UnmarshalTypeError.
+func kindOf(raw json.RawMessage) string {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return "value"
+	}
+
+	switch raw[0] {
+	case '{':
+		return "object"
+	case '[':
+		return "array"
+	case '"':
+		return "string"
+	case 't', 'f':
+		return "bool"
+	case 'n':
+		return "null"
+	}
+
+	return "number"
+}
+
+// isNull checks if the raw member is the JSON literal null.
+func isNull(raw json.RawMessage) bool {
+	return string(bytes.TrimSpace(raw)) == "null"
+}
@@ -0,0 +1,34 @@
+// Package httpx decodes JSON request bodies into structs of wrappers and renders decoding errors as problem details (RFC 9457).
+package httpx
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	ContentTypeJSON    = "application/json"         // Content type of request bodies accepted by the decoder. Types with a "+json" suffix are accepted as well.
+	ContentTypeProblem = "application/problem+json" // Content type of problem details written by WriteProblem.
+	DefaultMaxBodySize = 1 << 20                    // Default limit of request bodies in bytes.
+	TagName            = "json"                     // Struct tag holding the member name, as used by encoding/json.
+)
+
+var (
+	ErrUnsupportedMediaType = errors.New("unsupported media type") // The request does not declare a JSON content type.
+	ErrBodyTooLarge         = errors.New("request body too large") // The request body exceeds the maximum body size.
+	ErrMalformedBody        = errors.New("malformed request body") // The request body is empty or not valid JSON.
+)
+
+// pointer builds a JSON pointer (RFC 6901) from member names, e.g. "/address/city".
+func pointer(segments ...string) string {
+	var builder strings.Builder
+	for _, segment := range segments {
+		segment = strings.ReplaceAll(segment, "~", "~0")
+		segment = strings.ReplaceAll(segment, "/", "~1")
+
+		builder.WriteString("/")
+		builder.WriteString(segment)
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,280 @@
+package httpx
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zealsprince/wrappers"
+	"github.com/zealsprince/wrappers/regex"
+)
+
+type address struct {
+	City    *wrappers.WrapperString  `json:"city"`
+	Country *wrappers.WrapperCountry `json:"country"`
+}
+
+type signup struct {
+	Name     *wrappers.WrapperString   `json:"name"`
+	Email    *regex.WrapperRegexEmail  `json:"email"`
+	Age      *wrappers.WrapperInt      `json:"age,omitempty"`
+	Birthday *wrappers.WrapperDate     `json:"birthday"`
+	Address  *address                  `json:"address"`
+	Tags     []*wrappers.WrapperString `json:"tags"`
+	Note     string                    `json:"note"`
+}
+
+type order struct {
+	Items []struct {
+		Email wrappers.WrapperEmail `json:"email"`
+	} `json:"items"`
+	Addresses map[string]address      `json:"addresses"`
+	Contact   *wrappers.WrapperEmail  `json:"contact" wrappers:"discard"`
+	Backups   []wrappers.WrapperEmail `json:"backups" wrappers:"discard"`
+	Created   time.Time               `json:"created"`
+}
+
+func request(contentType, body string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/signup", strings.NewReader(body))
+	if contentType != "" {
+		r.Header.Set("Content-Type", contentType)
+	}
+
+	return r
+}
+
+func TestDecode(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		body := `{"name": "Jane", "email": "jane@example.com", "birthday": "1990-05-17", "address": {"city": "Berlin", "country": "DE"}, "tags": ["a", null], "note": "hi"}`
+
+		value, err := Decode[signup](request("application/json; charset=utf-8", body))
+		if err != nil {
+			t.Fatalf("Decode() error = %v", err)
+		}
+
+		if value.Name.Get() != "Jane" || value.Email.Get() != "jane@example.com" || value.Address.Country.Unwrap() != "Germany" || value.Note != "hi" {
+			t.Errorf("Decode() = %v, %v, %v, %v", value.Name.Get(), value.Email.Get(), value.Address.Country.Unwrap(), value.Note)
+		}
+
+		if value.Age != nil || len(value.Tags) != 2 {
+			t.Errorf("Decode() Age = %v, Tags = %v", value.Age, value.Tags)
+		}
+	})
+
+	t.Run("Invalid fields", func(t *testing.T) {
+		body := `{"name": "Jane", "email": "jane", "age": "old", "birthday": "1990-02-30", "address": {"country": "Atlantis"}, "tags": ["a", {}]}`
+
+		_, err := Decode[signup](request("application/json", body))
+
+		var validationErrors wrappers.ValidationErrors
+		if !errors.As(err, &validationErrors) {
+			t.Fatalf("Decode() error = %v, want ValidationErrors", err)
+		}
+
+		want := []string{"/email", "/age", "/birthday", "/address/country", "/tags/1"}
+		if len(validationErrors) != len(want) {
+			t.Fatalf("Decode() errors = %v, want %v", validationErrors, want)
+		}
+
+		for i, field := range want {
+			if validationErrors[i].Field != field {
+				t.Errorf("Field = %q, want %q", validationErrors[i].Field, field)
+			}
+		}
+	})
+
+	t.Run("Invalid type of other fields", func(t *testing.T) {
+		_, err := Decode[signup](request("application/json", `{"note": 5}`))
+
+		var validationErrors wrappers.ValidationErrors
+		if !errors.As(err, &validationErrors) || validationErrors[0].Field != "/note" {
+			t.Fatalf("Decode() error = %v, want ValidationErrors for /note", err)
+		}
+	})
+
+	t.Run("Invalid fields in slices and maps", func(t *testing.T) {
+		body := `{"items": [{"email": "jane@example.com"}, {"email": "jane"}], "addresses": {"home": {"country": "DE"}, "work": {"country": "Atlantis"}}}`
+
+		_, err := Decode[order](request("application/json", body))
+
+		var validationErrors wrappers.ValidationErrors
+		if !errors.As(err, &validationErrors) || errors.Is(err, ErrMalformedBody) {
+			t.Fatalf("Decode() error = %v, want ValidationErrors", err)
+		}
+
+		want := []string{"/items/1/email", "/addresses/work/country"}
+		if len(validationErrors) != len(want) {
+			t.Fatalf("Decode() errors = %v, want %v", validationErrors, want)
+		}
+
+		for i, field := range want {
+			if validationErrors[i].Field != field {
+				t.Errorf("Field = %q, want %q", validationErrors[i].Field, field)
+			}
+		}
+	})
+
+	t.Run("Valid slices and maps", func(t *testing.T) {
+		body := `{"items": [{"email": "jane@example.com"}], "addresses": {"home": {"city": "Berlin"}}, "created": "2024-01-01T12:00:00Z"}`
+
+		value, err := Decode[order](request("application/json", body))
+		if err != nil {
+			t.Fatalf("Decode() error = %v", err)
+		}
+
+		if len(value.Items) != 1 || value.Items[0].Email.Get() != "jane@example.com" || value.Addresses["home"].City.Get() != "Berlin" || value.Created.IsZero() {
+			t.Errorf("Decode() = %+v", value)
+		}
+	})
+
+	t.Run("Discarded fields", func(t *testing.T) {
+		body := `{"contact": "jane", "backups": ["jane@example.com", "jane"]}`
+
+		value, err := Decode[order](request("application/json", body))
+		if err != nil {
+			t.Fatalf("Decode() error = %v", err)
+		}
+
+		if value.Contact == nil || !value.Contact.IsDiscarded() {
+			t.Errorf("Contact = %v, want a discarded wrapper", value.Contact)
+		}
+
+		if len(value.Backups) != 2 || value.Backups[0].IsDiscarded() || !value.Backups[1].IsDiscarded() {
+			t.Errorf("Backups = %v, want the second one discarded", value.Backups)
+		}
+	})
+
+	t.Run("Invalid type of other nested fields", func(t *testing.T) {
+		_, err := Decode[order](request("application/json", `{"items": {}, "created": "yesterday"}`))
+
+		var validationErrors wrappers.ValidationErrors
+		if !errors.As(err, &validationErrors) || len(validationErrors) != 2 || validationErrors[0].Field != "/items" || validationErrors[1].Field != "/created" {
+			t.Fatalf("Decode() error = %v, want ValidationErrors for /items and /created", err)
+		}
+	})
+
+	t.Run("Request errors", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			contentType string
+			body        string
+			want        error
+		}{
+			{name: "Missing content type", body: `{}`, want: ErrUnsupportedMediaType},
+			{name: "Wrong content type", contentType: "text/plain", body: `{}`, want: ErrUnsupportedMediaType},
+			{name: "Empty body", contentType: "application/json", body: " ", want: ErrMalformedBody},
+			{name: "Invalid JSON", contentType: "application/json", body: `{"name": }`, want: ErrMalformedBody},
+			{name: "Not an object", contentType: "application/json", body: `["Jane"]`, want: ErrMalformedBody},
+			{name: "Too large", contentType: "application/json", body: `{"note": "` + strings.Repeat("x", 64) + `"}`, want: ErrBodyTooLarge},
+		}
+
+		decoder := NewDecoder[signup]()
+		decoder.SetMaxBodySize(64)
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if _, err := decoder.Decode(request(tt.contentType, tt.body)); !errors.Is(err, tt.want) {
+					t.Errorf("Decode() error = %v, want %v", err, tt.want)
+				}
+			})
+		}
+	})
+
+	t.Run("Suffixed content type", func(t *testing.T) {
+		if _, err := Decode[signup](request("application/merge-patch+json", `{"name": "Jane"}`)); err != nil {
+			t.Errorf("Decode() error = %v", err)
+		}
+	})
+}
+
+func TestWriteError(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		status      int
+		pointers    []string
+		codes       []string
+	}{
+		{name: "Unsupported media type", contentType: "text/plain", body: `{}`, status: http.StatusUnsupportedMediaType},
+		{name: "Malformed body", contentType: "application/json", body: `{`, status: http.StatusBadRequest},
+		{
+			name:        "Invalid fields",
+			contentType: "application/json",
+			body:        `{"email": "jane", "address": {"country": "Atlantis"}}`,
+			status:      http.StatusUnprocessableEntity,
+			pointers:    []string{"#/email", "#/address/country"},
+			codes:       []string{CodeInvalidValue, CodeInvalidValue},
+		},
+		{
+			name:        "Invalid type",
+			contentType: "application/json",
+			body:        `{"note": true}`,
+			status:      http.StatusUnprocessableEntity,
+			pointers:    []string{"#/note"},
+			codes:       []string{CodeInvalidType},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if _, err := Decode[signup](r); err != nil {
+					WriteError(w, r, err)
+					return
+				}
+
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request(tt.contentType, tt.body))
+
+			if recorder.Code != tt.status {
+				t.Errorf("status = %d, want %d", recorder.Code, tt.status)
+			}
+
+			if contentType := recorder.Header().Get("Content-Type"); contentType != ContentTypeProblem {
+				t.Errorf("Content-Type = %q, want %q", contentType, ContentTypeProblem)
+			}
+
+			var problem Problem
+			if err := json.Unmarshal(recorder.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+
+			if problem.Status != tt.status || problem.Title != http.StatusText(tt.status) || problem.Instance != "/signup" {
+				t.Errorf("problem = %+v", problem)
+			}
+
+			if len(problem.Errors) != len(tt.pointers) {
+				t.Fatalf("problem errors = %+v, want %v", problem.Errors, tt.pointers)
+			}
+
+			for i, field := range problem.Errors {
+				if field.Pointer != tt.pointers[i] || field.Code != tt.codes[i] || field.Detail == "" {
+					t.Errorf("problem error = %+v, want pointer %q and code %q", field, tt.pointers[i], tt.codes[i])
+				}
+			}
+		})
+	}
+
+	t.Run("Internal errors are not leaked", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		WriteError(recorder, nil, errors.New("database password is wrong"))
+
+		if recorder.Code != http.StatusInternalServerError || strings.Contains(recorder.Body.String(), "password") {
+			t.Errorf("response = %d %s", recorder.Code, recorder.Body.String())
+		}
+	})
+}
+
+func TestPointer(t *testing.T) {
+	if got := pointer("a/b", "m~n", "0"); got != "/a~1b/m~0n/0" {
+		t.Errorf("pointer() = %q, want %q", got, "/a~1b/m~0n/0")
+	}
+}
@@ -0,0 +1,131 @@
+package httpx
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/zealsprince/wrappers"
+)
+
+const (
+	CodeInvalidValue = "invalid_value" // The value was rejected by the wrapper of the field.
+	CodeInvalidType  = "invalid_type"  // The value has a JSON type that cannot be decoded into the field.
+	CodeInvalid      = "invalid"       // Any other error of the field.
+)
+
+// Problem is a problem details object as defined by RFC 9457, which obsoletes RFC 7807.
+type Problem struct {
+	Type     string         `json:"type,omitempty"` // URI identifying the problem type. Omitted, it is "about:blank".
+	Title    string         `json:"title"`
+	Status   int            `json:"status"`
+	Detail   string         `json:"detail,omitempty"`
+	Instance string         `json:"instance,omitempty"`
+	Errors   []ProblemField `json:"errors,omitempty"` // Extension member listing every invalid field.
+}
+
+// ProblemField describes an invalid field of the request body.
+type ProblemField struct {
+	Pointer string `json:"pointer"` // JSON pointer to the field as a URI fragment, e.g. "#/address/city".
+	Code    string `json:"code"`
+	Detail  string `json:"detail"`
+}
+
+func (problem *Problem) Error() string {
+	if problem.Detail == "" {
+		return problem.Title
+	}
+
+	return problem.Title + ": " + problem.Detail
+}
+
+// NewProblem converts an error returned by Decode into problem details:
+//   - ErrUnsupportedMediaType: 415 Unsupported Media Type
+//   - ErrBodyTooLarge: 413 Content Too Large
+//   - ErrMalformedBody: 400 Bad Request
+//   - ValidationErrors: 422 Unprocessable Content with an entry for every invalid field
+//
+// Other errors result in 500 Internal Server Error without details, so internal errors are not leaked.
+func NewProblem(err error) *Problem {
+	var problem *Problem
+	if errors.As(err, &problem) {
+		return problem
+	}
+
+	var validationErrors wrappers.ValidationErrors
+
+	switch {
+	case errors.Is(err, ErrUnsupportedMediaType):
+		problem = newProblem(http.StatusUnsupportedMediaType, err.Error())
+
+	case errors.Is(err, ErrBodyTooLarge):
+		problem = newProblem(http.StatusRequestEntityTooLarge, err.Error())
+
+	case errors.Is(err, ErrMalformedBody):
+		problem = newProblem(http.StatusBadRequest, err.Error())
+
+	case errors.As(err, &validationErrors):
+		problem = newProblem(http.StatusUnprocessableEntity, "the request body contains invalid fields")
+		for _, fieldError := range validationErrors {
+			problem.Errors = append(problem.Errors, ProblemField{
+				Pointer: "#" + fieldError.Field,
+				Code:    code(fieldError.Err),
+				Detail:  fieldError.Err.Error(),
+			})
+		}
+
+	default:
+		problem = newProblem(http.StatusInternalServerError, "")
+	}
+
+	return problem
+}
+
+func newProblem(status int, detail string) *Problem {
+	return &Problem{
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+	}
+}
+
+// code classifies the error of a field.
+func code(err error) string {
+	var validationError *wrappers.ValidationError
+	if errors.As(err, &validationError) {
+		return CodeInvalidValue
+	}
+
+	var typeError *json.UnmarshalTypeError
+	if errors.As(err, &typeError) {
+		return CodeInvalidType
+	}
+
+	return CodeInvalid
+}
+
+// WriteProblem writes the problem details as the response with the content type application/problem+json.
+func WriteProblem(w http.ResponseWriter, problem *Problem) error {
+	data, err := json.Marshal(problem)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", ContentTypeProblem)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(problem.Status)
+
+	_, err = w.Write(data)
+	return err
+}
+
+// WriteError converts the error into problem details with NewProblem and writes them as the response.
+// The path of the request is used as the instance of the problem.
+func WriteError(w http.ResponseWriter, r *http.Request, err error) error {
+	problem := NewProblem(err)
+	if problem.Instance == "" && r != nil {
+		problem.Instance = r.URL.Path
+	}
+
+	return WriteProblem(w, problem)
+}
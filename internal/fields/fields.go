@@ -0,0 +1,316 @@
+// Package fields walks structs of wrappers. It is shared by the sub-packages that bind external input such as flags,
+// environment variables, CSV records and forms onto wrapper fields.
+package fields
+
+import (
+	"encoding"
+	"flag"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/zealsprince/wrappers"
+)
+
+var (
+	providerType  = reflect.TypeOf((*wrappers.WrapperProvider)(nil)).Elem()
+	discarderType = reflect.TypeOf(wrappers.Discarder[wrappers.WrapperProvider]{})
+)
+
+// Options configures how a struct is walked.
+type Options struct {
+	Tag        string                           // Struct tag holding the name of a field. Fields tagged with "-" are skipped.
+	Name       func(name string) string         // Derives the name of untagged fields from their Go field name. Defaults to the Go field name.
+	Join       func(prefix, name string) string // Joins the name of a nested struct with the names of its fields. Defaults to prefix + name.
+	Allocate   bool                             // If true, nil pointers to wrappers and nested structs are allocated. Otherwise their fields are visited with an invalid value.
+	Discarders bool                             // If true, fields of wrappers.Discarder are visited like wrapper fields. Otherwise they are walked like nested structs.
+}
+
+// Field is a wrapper field or a slice of wrappers found while walking a struct.
+type Field struct {
+	Name        string              // Name of the field including the names of its enclosing structs.
+	StructField reflect.StructField // The Go struct field.
+	Value       reflect.Value       // The field value. Invalid if an enclosing struct pointer is nil and allocation is disabled.
+	Discard     bool                // The field is tagged with `wrappers:"discard"`, i.e. invalid values are discarded without an error.
+	allocate    bool
+	tag         string // Struct tag key the field was named by, see Options.
+}
+
+// IsSlice checks if the field is a slice of wrappers.
+func (field Field) IsSlice() bool {
+	return field.StructField.Type.Kind() == reflect.Slice
+}
+
+// IsDiscarder checks if the field is a wrappers.Discarder or a pointer to one.
+func (field Field) IsDiscarder() bool {
+	return IsDiscarder(field.StructField.Type)
+}
+
+// Wrapper returns the wrapper of the field, or the proxied wrapper of a Discarder. Nil pointers are allocated and initialized
+// if allocation is enabled and returned as nil otherwise. Slices return nil.
+func (field Field) Wrapper() wrappers.WrapperProvider {
+	if !field.Value.IsValid() || field.IsSlice() {
+		return nil
+	}
+
+	value := field.Value
+	if field.IsDiscarder() {
+		if value.Kind() == reflect.Pointer {
+			if value.IsNil() {
+				if !field.allocate {
+					return nil
+				}
+
+				value.Set(reflect.New(value.Type().Elem()))
+			}
+
+			value = value.Elem()
+		}
+
+		value = value.Field(0)
+	}
+
+	if value.Kind() != reflect.Pointer {
+		return value.Addr().Interface().(wrappers.WrapperProvider)
+	}
+
+	if value.IsNil() {
+		if !field.allocate {
+			return nil
+		}
+
+		value.Set(reflect.New(value.Type().Elem()))
+		wrapper := value.Interface().(wrappers.WrapperProvider)
+		wrapper.Initialize()
+	}
+
+	return value.Interface().(wrappers.WrapperProvider)
+}
+
+// Tag returns the value of the given struct tag key of the field.
+func (field Field) Tag(key string) (string, bool) {
+	return field.StructField.Tag.Lookup(key)
+}
+
+// HasOption checks if the tag the field was named by carries the option after its name, e.g. "required" in `env:"PORT,required"`.
+func (field Field) HasOption(option string) bool {
+	tag, _ := field.Tag(field.tag)
+	return HasOption(tag, option)
+}
+
+// HasOption checks if the comma separated options following the name of the tag value contain the option.
+func HasOption(tag string, option string) bool {
+	_, options, _ := strings.Cut(tag, ",")
+	for _, current := range strings.Split(options, ",") {
+		if current == option {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsWrapper checks if values of the type, or pointers to them, implement WrapperProvider.
+func IsWrapper(t reflect.Type) bool {
+	if t.Implements(providerType) {
+		return t.Kind() == reflect.Pointer
+	}
+
+	return reflect.PointerTo(t).Implements(providerType)
+}
+
+// IsDiscarder checks if the type, or the type pointed to, is a wrappers.Discarder.
+func IsDiscarder(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t.Kind() == reflect.Struct && t.PkgPath() == discarderType.PkgPath() && strings.HasPrefix(t.Name(), "Discarder[") &&
+		t.NumField() == 1 && t.Field(0).Name == "Proxy" && IsWrapper(t.Field(0).Type)
+}
+
+// NewWrapper creates an initialized wrapper for the given wrapper type, which may be a pointer or struct type.
+// It returns the value to store in a field of that type together with the wrapper itself.
+func NewWrapper(t reflect.Type) (reflect.Value, wrappers.WrapperProvider) {
+	if t.Kind() == reflect.Pointer {
+		value := reflect.New(t.Elem())
+		wrapper := value.Interface().(wrappers.WrapperProvider)
+		wrapper.Initialize()
+		return value, wrapper
+	}
+
+	pointer := reflect.New(t)
+	wrapper := pointer.Interface().(wrappers.WrapperProvider)
+	wrapper.Initialize()
+
+	return pointer.Elem(), wrapper
+}
+
+// Set wraps the text with the Set method of the wrapper (see flag.Value) if it has one. WrapperProvider does not require it,
+// so other wrappers fall back to the generic implementation.
+func Set(wrapper wrappers.WrapperProvider, text string) error {
+	if value, ok := wrapper.(flag.Value); ok {
+		return value.Set(text)
+	}
+
+	return wrappers.Set(text, wrapper)
+}
+
+// Text returns the text of the wrapper with its MarshalText method (see encoding.TextMarshaler) if it has one
+// and falls back to the generic implementation otherwise.
+func Text(wrapper wrappers.WrapperProvider) ([]byte, error) {
+	if marshaler, ok := wrapper.(encoding.TextMarshaler); ok {
+		return marshaler.MarshalText()
+	}
+
+	return wrappers.MarshalText(wrapper)
+}
+
+// Walk calls visit for every exported wrapper field and slice of wrappers of the struct pointed to by dst.
+// Nested structs, which do not implement WrapperProvider themselves, are walked recursively with their name as a prefix unless they are embedded without a tag.
+// Other fields are ignored.
+func Walk(dst any, options Options, visit func(Field) error) error {
+	value := reflect.ValueOf(dst)
+	if value.Kind() != reflect.Pointer || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("expected a non-nil pointer to a struct, got %T", dst)
+	}
+
+	return walk(value.Elem().Type(), value.Elem(), "", options, visit)
+}
+
+// WalkType is like Walk but only inspects the struct type. All visited fields have an invalid value.
+func WalkType(t reflect.Type, options Options, visit func(Field) error) error {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct {
+		return fmt.Errorf("expected a struct type, got %s", t)
+	}
+
+	options.Allocate = false
+
+	return walk(t, reflect.Value{}, "", options, visit)
+}
+
+func walk(t reflect.Type, value reflect.Value, prefix string, options Options, visit func(Field) error) error {
+	for i := 0; i < t.NumField(); i++ {
+		structField := t.Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+
+		name, tagged := structField.Tag.Lookup(options.Tag)
+		name, _, _ = strings.Cut(name, ",")
+		if name == "-" {
+			continue
+		}
+
+		if !tagged || name == "" {
+			name = structField.Name
+			if options.Name != nil {
+				name = options.Name(name)
+			}
+		}
+
+		if prefix != "" {
+			if options.Join != nil {
+				name = options.Join(prefix, name)
+			} else {
+				name = prefix + name
+			}
+		}
+
+		// Embedded structs without a tag do not add a prefix to the names of their fields.
+		nested := name
+		if structField.Anonymous && !tagged {
+			nested = prefix
+		}
+
+		var fieldValue reflect.Value
+		if value.IsValid() {
+			fieldValue = value.Field(i)
+		}
+
+		fieldType := structField.Type
+
+		switch {
+		case IsWrapper(fieldType), fieldType.Kind() == reflect.Slice && IsWrapper(fieldType.Elem()), options.Discarders && IsDiscarder(fieldType):
+			field := Field{
+				Name:        name,
+				StructField: structField,
+				Value:       fieldValue,
+				allocate:    options.Allocate,
+				tag:         options.Tag,
+			}
+
+			if tag, ok := structField.Tag.Lookup(wrappers.WrappersTagHeader); ok {
+				for _, option := range strings.Split(tag, ",") {
+					if option == wrappers.WrappersTagDiscard {
+						field.Discard = true
+					}
+				}
+			}
+
+			if err := visit(field); err != nil {
+				return err
+			}
+
+		case fieldType.Kind() == reflect.Struct:
+			if err := walk(fieldType, fieldValue, nested, options, visit); err != nil {
+				return err
+			}
+
+		case fieldType.Kind() == reflect.Pointer && fieldType.Elem().Kind() == reflect.Struct:
+			if fieldValue.IsValid() && fieldValue.IsNil() {
+				if options.Allocate {
+					fieldValue.Set(reflect.New(fieldType.Elem()))
+				} else {
+					fieldValue = reflect.Value{}
+				}
+			}
+
+			if fieldValue.IsValid() {
+				fieldValue = fieldValue.Elem()
+			}
+
+			if err := walk(fieldType.Elem(), fieldValue, nested, options, visit); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Kebab converts a Go field name into kebab case, e.g. "MaxRetries" into "max-retries" and "HTTPPort" into "http-port".
+func Kebab(name string) string {
+	return strings.Join(words(name), "-")
+}
+
+// Snake converts a Go field name into upper snake case, e.g. "MaxRetries" into "MAX_RETRIES".
+func Snake(name string) string {
+	return strings.ToUpper(strings.Join(words(name), "_"))
+}
+
+// words splits a Go field name into lower case words at case changes while keeping acronyms together.
+func words(name string) []string {
+	runes := []rune(name)
+
+	var result []string
+	start := 0
+	for i := 1; i < len(runes); i++ {
+		previous, current := runes[i-1], runes[i]
+
+		boundary := unicode.IsLower(previous) && unicode.IsUpper(current) ||
+			unicode.IsUpper(previous) && unicode.IsUpper(current) && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+
+		if boundary {
+			result = append(result, strings.ToLower(string(runes[start:i])))
+			start = i
+		}
+	}
+
+	return append(result, strings.ToLower(string(runes[start:])))
+}
@@ -0,0 +1,217 @@
+package fields
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zealsprince/wrappers"
+)
+
+func TestCase(t *testing.T) {
+	tests := []struct {
+		name  string
+		kebab string
+		snake string
+	}{
+		{name: "Port", kebab: "port", snake: "PORT"},
+		{name: "MaxRetries", kebab: "max-retries", snake: "MAX_RETRIES"},
+		{name: "HTTPPort", kebab: "http-port", snake: "HTTP_PORT"},
+		{name: "UserID", kebab: "user-id", snake: "USER_ID"},
+		{name: "Address2", kebab: "address2", snake: "ADDRESS2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Kebab(tt.name); got != tt.kebab {
+				t.Errorf("Kebab() = %q, want %q", got, tt.kebab)
+			}
+
+			if got := Snake(tt.name); got != tt.snake {
+				t.Errorf("Snake() = %q, want %q", got, tt.snake)
+			}
+		})
+	}
+}
+
+func TestHasOption(t *testing.T) {
+	tests := []struct {
+		tag    string
+		option string
+		want   bool
+	}{
+		{tag: "port,required", option: "required", want: true},
+		{tag: ",omitempty,required", option: "required", want: true},
+		{tag: "required", option: "required", want: false},
+		{tag: "port,omitempty", option: "required", want: false},
+		{tag: "", option: "required", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			if got := HasOption(tt.tag, tt.option); got != tt.want {
+				t.Errorf("HasOption(%q, %q) = %v, want %v", tt.tag, tt.option, got, tt.want)
+			}
+		})
+	}
+
+	type Data struct {
+		Port *wrappers.WrapperInt    `test:"port,required" other:"port"`
+		Host *wrappers.WrapperString `test:"host" other:"host,required"`
+	}
+
+	required := map[string]bool{}
+	err := WalkType(reflect.TypeOf(Data{}), Options{Tag: "test"}, func(field Field) error {
+		required[field.Name] = field.HasOption("required")
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WalkType() error = %v", err)
+	}
+
+	if !required["port"] || required["host"] {
+		t.Errorf("HasOption() = %v, want only port to be required by the walked tag", required)
+	}
+}
+
+func TestWalk(t *testing.T) {
+	type Nested struct {
+		Value *wrappers.WrapperInt `test:"value"`
+	}
+
+	type Embedded struct {
+		Flat *wrappers.WrapperInt
+	}
+
+	type Data struct {
+		Embedded
+		Name     *wrappers.WrapperString   `test:"name" wrappers:"discard"`
+		Count    wrappers.WrapperInt       // Untagged fields use their Go name.
+		Tags     []*wrappers.WrapperString `test:"tags"`
+		Nested   Nested                    `test:"nested"`
+		Pointer  *Nested                   `test:"pointer"`
+		Skipped  *wrappers.WrapperInt      `test:"-"`
+		Ignored  string
+		internal *wrappers.WrapperInt
+	}
+
+	options := Options{
+		Tag:      "test",
+		Join:     func(prefix, name string) string { return prefix + "." + name },
+		Allocate: true,
+	}
+
+	var data Data
+	var names []string
+	err := Walk(&data, options, func(field Field) error {
+		names = append(names, field.Name)
+
+		if field.Name == "name" && !field.Discard {
+			t.Errorf("Discard = false for %q, want true", field.Name)
+		}
+
+		if !field.IsSlice() && field.Wrapper() == nil {
+			t.Errorf("Wrapper() = nil for %q", field.Name)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Walk() error = %v", err)
+	}
+
+	want := []string{"Flat", "name", "Count", "tags", "nested.value", "pointer.value"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("Walk() names = %v, want %v", names, want)
+	}
+
+	if data.Name == nil || !data.Name.IsInitialized() || data.Pointer == nil || data.Pointer.Value == nil {
+		t.Errorf("Walk() did not allocate and initialize nil pointers")
+	}
+
+	t.Run("Type", func(t *testing.T) {
+		var typeNames []string
+		err := WalkType(reflect.TypeOf(Data{}), options, func(field Field) error {
+			typeNames = append(typeNames, field.Name)
+
+			if field.Wrapper() != nil {
+				t.Errorf("Wrapper() = %v for %q, want nil", field.Wrapper(), field.Name)
+			}
+
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("WalkType() error = %v", err)
+		}
+
+		if !reflect.DeepEqual(typeNames, want) {
+			t.Errorf("WalkType() names = %v, want %v", typeNames, want)
+		}
+	})
+
+	t.Run("Not a struct pointer", func(t *testing.T) {
+		if err := Walk(data, options, func(Field) error { return nil }); err == nil {
+			t.Errorf("Walk() error = nil, want error")
+		}
+	})
+}
+
+// plain only implements WrapperProvider, i.e. it has neither Set nor MarshalText.
+func TestWalkDiscarders(t *testing.T) {
+	type Data struct {
+		Value   wrappers.Discarder[*wrappers.WrapperInt]  `test:"value"`
+		Pointer *wrappers.Discarder[*wrappers.WrapperInt] `test:"pointer"`
+	}
+
+	var data Data
+	var names []string
+
+	err := Walk(&data, Options{Tag: "test", Allocate: true, Discarders: true}, func(field Field) error {
+		if !field.IsDiscarder() {
+			t.Errorf("%s: IsDiscarder() = false", field.Name)
+		}
+
+		names = append(names, field.Name)
+		return Set(field.Wrapper(), "42")
+	})
+	if err != nil {
+		t.Fatalf("Walk() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(names, []string{"value", "pointer"}) {
+		t.Errorf("names = %v, want value and pointer", names)
+	}
+
+	if data.Value.Proxy.Unwrap() != 42 || data.Pointer == nil || data.Pointer.Proxy.Unwrap() != 42 {
+		t.Errorf("Walk() did not set the proxied wrappers")
+	}
+
+	if IsDiscarder(reflect.TypeOf(plain{})) {
+		t.Errorf("IsDiscarder(plain) = true, want false")
+	}
+}
+
+type plain struct {
+	wrappers.Wrapper[string, string]
+}
+
+func (wrapper *plain) Wrap(value any, discard bool) error {
+	wrapper.Value, _ = value.(string)
+	return nil
+}
+
+func (wrapper *plain) MarshalJSON() ([]byte, error)    { return wrappers.MarshalJSON(wrapper) }
+func (wrapper *plain) UnmarshalJSON(data []byte) error { return wrappers.UnmarshalJSON(data, wrapper) }
+func (wrapper *plain) UnwrapAny() any                  { return wrapper.Value }
+func (wrapper *plain) GetAny() any                     { return wrapper.Value }
+
+func TestSetText(t *testing.T) {
+	for _, wrapper := range []wrappers.WrapperProvider{wrappers.New[*wrappers.WrapperString](), &plain{}} {
+		if err := Set(wrapper, "text"); err != nil {
+			t.Fatalf("Set(%T) error = %v", wrapper, err)
+		}
+
+		if text, err := Text(wrapper); err != nil || string(text) != "text" {
+			t.Errorf("Text(%T) = %q, %v, want %q", wrapper, text, err, "text")
+		}
+	}
+}
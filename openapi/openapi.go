@@ -0,0 +1,165 @@
+// Package openapi generates OpenAPI 3.1 components, i.e. schemas, parameters, request bodies and responses, from structs of wrappers.
+// Schemas are generated by the schema package, so they carry the examples and descriptions of their wrappers and fields.
+package openapi
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/zealsprince/wrappers/internal/fields"
+	"github.com/zealsprince/wrappers/schema"
+)
+
+const (
+	Version         = "3.1.0"                 // Version of the OpenAPI specification the components conform to.
+	SchemasRef      = "#/components/schemas/" // Prefix of references to named structs.
+	ContentTypeJSON = "application/json"      // Media type of request and response bodies.
+	TagName         = "form"                  // Struct tag holding the name of a parameter, as used by the form package.
+	TagRequired     = "required"              // Option of the form tag marking a parameter as required, e.g. `form:"page,required"`.
+)
+
+// Locations of parameters.
+const (
+	InQuery  = "query"
+	InHeader = "header"
+	InPath   = "path"
+	InCookie = "cookie"
+)
+
+// Parameter is an OpenAPI parameter object.
+type Parameter struct {
+	Name        string         `json:"name"`
+	In          string         `json:"in"`
+	Description string         `json:"description,omitempty"`
+	Required    bool           `json:"required,omitempty"`
+	Schema      *schema.Schema `json:"schema"`
+	Example     any            `json:"example,omitempty"` // The first example of the schema, if any.
+}
+
+// MediaType is an OpenAPI media type object.
+type MediaType struct {
+	Schema *schema.Schema `json:"schema"`
+}
+
+// RequestBody is an OpenAPI request body object.
+type RequestBody struct {
+	Description string               `json:"description,omitempty"`
+	Required    bool                 `json:"required,omitempty"`
+	Content     map[string]MediaType `json:"content"`
+}
+
+// Response is an OpenAPI response object.
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// Components is an OpenAPI components object.
+type Components struct {
+	Schemas       map[string]*schema.Schema `json:"schemas,omitempty"`
+	Parameters    map[string]*Parameter     `json:"parameters,omitempty"`
+	RequestBodies map[string]*RequestBody   `json:"requestBodies,omitempty"`
+	Responses     map[string]*Response      `json:"responses,omitempty"`
+}
+
+// Generator generates the components of an API. Named structs encountered by any of its methods are collected as schemas
+// and referenced from "#/components/schemas/", so a single generator should be used for all operations of a document.
+type Generator struct {
+	schemas *schema.Generator
+}
+
+// NewGenerator creates a generator without any components.
+func NewGenerator() *Generator {
+	schemas := schema.NewGenerator()
+	schemas.SetRefPrefix(SchemasRef)
+
+	return &Generator{schemas: schemas}
+}
+
+// Schema generates the schema of the type. Named structs are returned as references to their component schemas.
+func (generator *Generator) Schema(t reflect.Type) (*schema.Schema, error) {
+	return generator.schemas.Schema(t)
+}
+
+// Parameters generates the parameters of the given location from the wrapper fields of the struct.
+// Like the form package, names are read from the form tag and nested structs use bracket notation, e.g. "address[city]".
+// Parameters are optional unless their tag has the required option. Path parameters are always required.
+// Fields of Discarder are nullable and never required, as their invalid values are discarded.
+// Descriptions are read from the description tag.
+func (generator *Generator) Parameters(t reflect.Type, in string) ([]*Parameter, error) {
+	var parameters []*Parameter
+
+	options := fields.Options{
+		Tag:        TagName,
+		Join:       func(prefix, name string) string { return prefix + "[" + name + "]" },
+		Discarders: true,
+	}
+
+	err := fields.WalkType(t, options, func(field fields.Field) error {
+		parameterSchema, err := generator.schemas.Schema(field.StructField.Type)
+		if err != nil {
+			return fmt.Errorf("field %s: %w", field.Name, err)
+		}
+
+		parameter := &Parameter{
+			Name:     field.Name,
+			In:       in,
+			Required: in == InPath || !field.IsDiscarder() && field.HasOption(TagRequired),
+			Schema:   parameterSchema,
+		}
+
+		parameter.Description, _ = field.Tag(schema.TagDescription)
+
+		if examples := parameterSchema.Examples; len(examples) > 0 {
+			parameter.Example = examples[0]
+		}
+
+		parameters = append(parameters, parameter)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return parameters, nil
+}
+
+// RequestBody generates a required JSON request body of the type, commonly a struct of wrappers.
+func (generator *Generator) RequestBody(t reflect.Type, description string) (*RequestBody, error) {
+	content, err := generator.content(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RequestBody{Description: description, Required: true, Content: content}, nil
+}
+
+// Response generates a JSON response of the type. A nil type generates a response without content.
+func (generator *Generator) Response(t reflect.Type, description string) (*Response, error) {
+	if t == nil {
+		return &Response{Description: description}, nil
+	}
+
+	content, err := generator.content(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Response{Description: description, Content: content}, nil
+}
+
+// Components returns the schemas of all named structs encountered so far.
+func (generator *Generator) Components() *Components {
+	return &Components{Schemas: generator.schemas.Definitions()}
+}
+
+// content generates the JSON content of a request body or response.
+func (generator *Generator) content(t reflect.Type) (map[string]MediaType, error) {
+	contentSchema, err := generator.schemas.Schema(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]MediaType{ContentTypeJSON: {Schema: contentSchema}}, nil
+}
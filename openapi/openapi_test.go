@@ -0,0 +1,128 @@
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/zealsprince/wrappers"
+	"github.com/zealsprince/wrappers/regex"
+)
+
+type search struct {
+	Query  *wrappers.WrapperString `form:"q,required" description:"Text to search for."`
+	Page   *wrappers.WrapperInt    `form:"page"`
+	Strict *wrappers.WrapperBool   `form:"strict"`
+	Tags   []*wrappers.WrapperString
+	Since  *wrappers.Discarder[*wrappers.WrapperDate] `form:"since,required"`
+	Near   struct {
+		Country *wrappers.WrapperCountry `form:"country"`
+	} `form:"near"`
+}
+
+type account struct {
+	Email   *regex.WrapperRegexEmail                   `json:"email" description:"Address the account is registered with."`
+	Created *wrappers.Discarder[*wrappers.WrapperTime] `json:"created,omitempty"`
+}
+
+func TestParameters(t *testing.T) {
+	generator := NewGenerator()
+
+	parameters, err := generator.Parameters(reflect.TypeOf(search{}), InQuery)
+	if err != nil {
+		t.Fatalf("Parameters() error = %v", err)
+	}
+
+	names := make(map[string]*Parameter)
+	for _, parameter := range parameters {
+		if parameter.In != InQuery {
+			t.Errorf("%s: In = %q, want %q", parameter.Name, parameter.In, InQuery)
+		}
+		names[parameter.Name] = parameter
+	}
+
+	if len(names) != 6 || names["q"] == nil || names["page"] == nil || names["Tags"] == nil || names["since"] == nil || names["near[country]"] == nil {
+		t.Fatalf("Parameters() = %v, want q, page, strict, Tags, since and near[country]", names)
+	}
+
+	if query := names["q"]; !query.Required || query.Description != "Text to search for." || query.Schema.Type != "string" {
+		t.Errorf("q = %+v, want a required described string", query)
+	}
+
+	if page := names["page"]; page.Required || page.Schema.Type != "integer" {
+		t.Errorf("page = %+v, want an optional integer", page)
+	}
+
+	if example, _ := json.Marshal(names["strict"].Example); string(example) != "true" {
+		t.Errorf("strict example = %s, want true", example)
+	}
+
+	if tags := names["Tags"]; tags.Schema.Type != "array" || tags.Schema.Items.Type != "string" {
+		t.Errorf("Tags = %+v, want an array of strings", tags.Schema)
+	}
+
+	if since := names["since"]; since.Required || !reflect.DeepEqual(since.Schema.Type, []string{"string", "null"}) {
+		t.Errorf("since = %+v, want an optional nullable string", since.Schema)
+	}
+
+	t.Run("Path", func(t *testing.T) {
+		type path struct {
+			ID *wrappers.WrapperInt `form:"id"`
+		}
+
+		parameters, err := generator.Parameters(reflect.TypeOf(&path{}), InPath)
+		if err != nil {
+			t.Fatalf("Parameters() error = %v", err)
+		}
+
+		if len(parameters) != 1 || !parameters[0].Required {
+			t.Errorf("Parameters() = %+v, want a required path parameter", parameters)
+		}
+	})
+
+	t.Run("Not a struct", func(t *testing.T) {
+		if _, err := generator.Parameters(reflect.TypeOf(""), InQuery); err == nil {
+			t.Errorf("Parameters() error = nil, want error")
+		}
+	})
+}
+
+func TestComponents(t *testing.T) {
+	generator := NewGenerator()
+
+	body, err := generator.RequestBody(reflect.TypeOf(&account{}), "Account to create.")
+	if err != nil {
+		t.Fatalf("RequestBody() error = %v", err)
+	}
+
+	if !body.Required || body.Content[ContentTypeJSON].Schema.Ref != SchemasRef+"account" {
+		t.Errorf("RequestBody() = %+v, want a required reference to the account", body)
+	}
+
+	response, err := generator.Response(reflect.TypeOf([]account{}), "Accounts.")
+	if err != nil {
+		t.Fatalf("Response() error = %v", err)
+	}
+
+	if items := response.Content[ContentTypeJSON].Schema.Items; items == nil || items.Ref != SchemasRef+"account" {
+		t.Errorf("Response() = %+v, want an array of accounts", response)
+	}
+
+	if empty, _ := generator.Response(nil, "No content."); empty.Content != nil {
+		t.Errorf("Response(nil) = %+v, want no content", empty)
+	}
+
+	got, err := json.Marshal(generator.Components())
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	want := `{"schemas":{"account":{"type":"object","properties":{` +
+		`"created":{"type":["string","null"],"format":"date-time","examples":["2024-01-01T12:00:00Z","2024-01-01T12:00:00+02:00"]},` +
+		`"email":{"description":"Address the account is registered with.","type":["string","null"],"pattern":"^[a-zA-Z0-9._%+\\-]+@[a-zA-Z0-9.\\-]+\\.[a-zA-Z]{2,}$","examples":["alice@example.com"]}},` +
+		`"required":["email"]}}}`
+
+	if string(got) != want {
+		t.Errorf("json.Marshal() = %s, want %s", got, want)
+	}
+}
@@ -1,12 +1,15 @@
 package regex
 
 import (
+	"encoding/xml"
+
 	"github.com/zealsprince/wrappers"
 )
 
 const (
 	WrapperRegexEmailName    wrappers.Name = "WrapperRegexEmail"
 	WrapperRegexEmailPattern string        = `^[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}$`
+	WrapperRegexEmailExample string        = "alice@example.com"
 )
 
 // WrapperRegexEmail is a specialized wrapper for validating email addresses.
@@ -26,3 +29,51 @@ func (wrapper *WrapperRegexEmail) UnmarshalJSON(data []byte) error {
 	}
 	return wrapper.WrapperRegex.UnmarshalJSON(data)
 }
+
+// UnmarshalText ensures the wrapper is initialized before unmarshalling and proxies the call.
+func (wrapper *WrapperRegexEmail) UnmarshalText(data []byte) error {
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+	return wrapper.WrapperRegex.UnmarshalText(data)
+}
+
+// Scan ensures the wrapper is initialized before scanning and proxies the call.
+func (wrapper *WrapperRegexEmail) Scan(src any) error {
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+	return wrapper.WrapperRegex.Scan(src)
+}
+
+// UnmarshalXML ensures the wrapper is initialized before unmarshalling and proxies the call.
+func (wrapper *WrapperRegexEmail) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+	return wrapper.WrapperRegex.UnmarshalXML(decoder, start)
+}
+
+// UnmarshalXMLAttr ensures the wrapper is initialized before unmarshalling and proxies the call.
+func (wrapper *WrapperRegexEmail) UnmarshalXMLAttr(attr xml.Attr) error {
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+	return wrapper.WrapperRegex.UnmarshalXMLAttr(attr)
+}
+
+// Set ensures the wrapper is initialized before unmarshalling and proxies the call.
+func (wrapper *WrapperRegexEmail) Set(value string) error {
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+	return wrapper.WrapperRegex.Set(value)
+}
+
+// Schema describes the pattern of the wrapper together with its examples.
+func (wrapper *WrapperRegexEmail) Schema() wrappers.Schema {
+	schema := wrapper.WrapperRegex.Schema()
+	schema.Examples = wrappers.SchemaExamples(WrapperRegexEmailExample, wrapper)
+
+	return schema
+}
@@ -1,10 +1,15 @@
 package regex
 
-import "github.com/zealsprince/wrappers"
+import (
+	"encoding/xml"
+
+	"github.com/zealsprince/wrappers"
+)
 
 const (
 	WrapperRegexPhoneName    wrappers.Name = "WrapperRegexPhone"
 	WrapperRegexPhonePattern string        = `^(?:\+?[1-9]\d{1,14}|0\d{1,14})$`
+	WrapperRegexPhoneExample string        = "+4930123456, 030123456"
 )
 
 type WrapperRegexPhone struct {
@@ -22,3 +27,46 @@ func (wrapper *WrapperRegexPhone) UnmarshalJSON(data []byte) error {
 	}
 	return wrapper.WrapperRegex.UnmarshalJSON(data)
 }
+
+func (wrapper *WrapperRegexPhone) UnmarshalText(data []byte) error {
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+	return wrapper.WrapperRegex.UnmarshalText(data)
+}
+
+func (wrapper *WrapperRegexPhone) Scan(src any) error {
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+	return wrapper.WrapperRegex.Scan(src)
+}
+
+func (wrapper *WrapperRegexPhone) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+	return wrapper.WrapperRegex.UnmarshalXML(decoder, start)
+}
+
+func (wrapper *WrapperRegexPhone) UnmarshalXMLAttr(attr xml.Attr) error {
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+	return wrapper.WrapperRegex.UnmarshalXMLAttr(attr)
+}
+
+func (wrapper *WrapperRegexPhone) Set(value string) error {
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+	return wrapper.WrapperRegex.Set(value)
+}
+
+// Schema describes the pattern of the wrapper together with its examples.
+func (wrapper *WrapperRegexPhone) Schema() wrappers.Schema {
+	schema := wrapper.WrapperRegex.Schema()
+	schema.Examples = wrappers.SchemaExamples(WrapperRegexPhoneExample, wrapper)
+
+	return schema
+}
@@ -1,17 +1,229 @@
 package regex
 
-import "github.com/zealsprince/wrappers"
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/biter777/countries"
+	"github.com/zealsprince/wrappers"
+)
 
 const (
 	WrapperRegexSepaBicName    wrappers.Name = "WrapperRegexSepaBic"
 	WrapperRegexSepaBicPattern string        = `^[A-Z]{6,6}[A-Z2-9][A-NP-Z0-9]([A-Z0-9]{3,3}){0,1}$`
+	WrapperRegexSepaBicExample string        = "DEUTDEFF, DEUTDEFF500"
+
+	WrapperRegexSepaBicPrimaryBranch string = "XXX" // The branch code used by the primary office of an institution.
 )
 
+// WrapperRegexSepaBic validates a BIC (ISO 9362) by its pattern and additionally checks that the country segment is an existing ISO 3166 country.
 type WrapperRegexSepaBic struct {
 	WrapperRegex
+	appendBranch bool                  // If true, 8 character BICs are normalized to their 11 character form by appending the primary branch code.
+	iban         *WrapperRegexSepaIban // If set, the BIC country has to match the country of this IBAN.
 }
 
 func (wrapper *WrapperRegexSepaBic) Initialize() {
 	wrapper.WrapperRegex.SetPattern(WrapperRegexSepaBicName, WrapperRegexSepaBicPattern)
 	wrapper.WrapperBase.Initialize()
 }
+
+// SetAppendBranch configures whether 8 character BICs are stored in their 11 character form with the "XXX" primary branch code appended.
+func (wrapper *WrapperRegexSepaBic) SetAppendBranch(appendBranch bool) {
+	wrapper.appendBranch = appendBranch
+}
+
+// SetIban pairs the BIC with an IBAN. Subsequent wraps require the BIC country to match the country of the IBAN, unless the IBAN is
+// discarded or of an unknown country. Passing nil removes the pairing.
+// The pairing only applies to wrappers configured manually. Wrappers allocated while unmarshalling, e.g. from JSON, are not paired,
+// so structs holding both should be checked with CheckSepaCountries afterwards.
+func (wrapper *WrapperRegexSepaBic) SetIban(iban *WrapperRegexSepaIban) {
+	wrapper.iban = iban
+}
+
+// Wrap validates the value against the BIC pattern, checks its country segment and normalizes it.
+func (wrapper *WrapperRegexSepaBic) Wrap(value any, discard bool) error {
+	if err := wrapper.WrapperRegex.Wrap(value, discard); err != nil {
+		return err
+	}
+
+	// The regex wrapper already discarded the value (and reported if necessary).
+	if wrapper.IsDiscarded() {
+		return nil
+	}
+
+	bic := wrapper.Value
+
+	country := countries.ByName(bic[4:6])
+	if country == countries.Unknown || country.Alpha2() != bic[4:6] {
+		wrapper.Discard()
+		if !discard {
+			return wrappers.ErrorValue(WrapperRegexSepaBicName, bic, "ISO 3166 country code in positions 5-6")
+		}
+		return nil
+	}
+
+	if wrapper.iban != nil {
+		if err := sepaCountries(wrapper.iban, bic, country); err != nil {
+			wrapper.Discard()
+			if !discard {
+				return err
+			}
+			return nil
+		}
+	}
+
+	if wrapper.appendBranch && len(bic) == 8 {
+		bic += WrapperRegexSepaBicPrimaryBranch
+	}
+
+	wrapper.Value = bic
+
+	return nil
+}
+
+// CheckSepaCountries checks that the countries of a BIC and an IBAN match, e.g. after unmarshalling both fields of a struct.
+// Nil and discarded wrappers as well as IBANs of unknown countries are not checked. Unlike SetIban, the BIC is not discarded on a mismatch.
+func CheckSepaCountries(iban *WrapperRegexSepaIban, bic *WrapperRegexSepaBic) error {
+	if iban == nil || bic == nil || bic.IsDiscarded() {
+		return nil
+	}
+
+	return sepaCountries(iban, bic.Value, bic.Country())
+}
+
+// sepaCountries returns an error if the country of the BIC differs from the country of the IBAN. IBANs without a known country are skipped.
+func sepaCountries(iban *WrapperRegexSepaIban, bic string, country countries.CountryCode) error {
+	ibanCountry := iban.Country() // Unknown if the IBAN is discarded.
+	if ibanCountry == countries.Unknown || ibanCountry == country {
+		return nil
+	}
+
+	return wrappers.ErrorValue(WrapperRegexSepaBicName, bic, fmt.Sprintf("country matching IBAN country %s", ibanCountry.Alpha2()))
+}
+
+// BankCode returns the four letter institution code of the BIC.
+func (wrapper *WrapperRegexSepaBic) BankCode() string {
+	if wrapper.IsDiscarded() || len(wrapper.Value) < 8 {
+		return ""
+	}
+
+	return wrapper.Value[0:4]
+}
+
+// Country returns the country of the BIC.
+func (wrapper *WrapperRegexSepaBic) Country() countries.CountryCode {
+	if wrapper.IsDiscarded() || len(wrapper.Value) < 8 {
+		return countries.Unknown
+	}
+
+	return countries.ByName(wrapper.Value[4:6])
+}
+
+// Location returns the two character location code of the BIC.
+func (wrapper *WrapperRegexSepaBic) Location() string {
+	if wrapper.IsDiscarded() || len(wrapper.Value) < 8 {
+		return ""
+	}
+
+	return wrapper.Value[6:8]
+}
+
+// Branch returns the three character branch code of the BIC. BICs without a branch code refer to the primary office and return "XXX".
+func (wrapper *WrapperRegexSepaBic) Branch() string {
+	if wrapper.IsDiscarded() || len(wrapper.Value) < 8 {
+		return ""
+	}
+
+	if len(wrapper.Value) == 8 {
+		return WrapperRegexSepaBicPrimaryBranch
+	}
+
+	return wrapper.Value[8:11]
+}
+
+// UnmarshalJSON ensures the wrapper is initialized before unmarshalling and validates the country segment.
+func (wrapper *WrapperRegexSepaBic) UnmarshalJSON(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+
+	return wrappers.UnmarshalJSON(data, wrapper)
+}
+
+// UnmarshalText ensures the wrapper is initialized before unmarshalling and validates the country segment.
+func (wrapper *WrapperRegexSepaBic) UnmarshalText(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+
+	return wrappers.UnmarshalText(data, wrapper)
+}
+
+// Scan ensures the wrapper is initialized before scanning and validates the country segment.
+func (wrapper *WrapperRegexSepaBic) Scan(src any) error {
+	if wrapper == nil {
+		return fmt.Errorf("scan into nil wrapper")
+	}
+
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+
+	return wrappers.Scan(src, wrapper)
+}
+
+// UnmarshalXML ensures the wrapper is initialized before unmarshalling and validates the country segment.
+func (wrapper *WrapperRegexSepaBic) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+
+	return wrappers.UnmarshalXML(decoder, start, wrapper)
+}
+
+// UnmarshalXMLAttr ensures the wrapper is initialized before unmarshalling and validates the country segment.
+func (wrapper *WrapperRegexSepaBic) UnmarshalXMLAttr(attr xml.Attr) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+
+	return wrappers.UnmarshalXMLAttr(attr, wrapper)
+}
+
+// Set ensures the wrapper is initialized before unmarshalling and validates the country segment.
+func (wrapper *WrapperRegexSepaBic) Set(value string) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+
+	return wrappers.Set(value, wrapper)
+}
+
+// Schema describes the pattern of the wrapper together with its examples.
+func (wrapper *WrapperRegexSepaBic) Schema() wrappers.Schema {
+	schema := wrapper.WrapperRegex.Schema()
+	schema.Examples = wrappers.SchemaExamples(WrapperRegexSepaBicExample, wrapper)
+
+	return schema
+}
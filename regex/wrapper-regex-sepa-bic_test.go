@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/biter777/countries"
 	"github.com/zealsprince/wrappers"
 )
 
@@ -255,3 +256,222 @@ func TestWrapperRegexSepaBic_Unmarshal(t *testing.T) {
 		})
 	}
 }
+
+// TestWrapperRegexSepaBic_Country tests the country validation of WrapperRegexSepaBic.
+func TestWrapperRegexSepaBic_Country(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		discard     bool
+		wantError   bool
+		wantDiscard bool
+	}{
+		{
+			name:        "Existing country",
+			input:       "DEUTDEFF500",
+			discard:     false,
+			wantError:   false,
+			wantDiscard: false,
+		},
+		{
+			name:        "Non-existent country",
+			input:       "DEUTZZFF",
+			discard:     false,
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Non-existent country discard",
+			input:       "DEUTZZFF",
+			discard:     true,
+			wantError:   false,
+			wantDiscard: true,
+		},
+		{
+			name:        "Country alias is not an ISO code",
+			input:       "BARCUK22",
+			discard:     false,
+			wantError:   true,
+			wantDiscard: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapper := wrappers.New[*WrapperRegexSepaBic]()
+
+			err := wrapper.Wrap(tt.input, tt.discard)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("Wrap() error = %v, wantError %v", err, tt.wantError)
+			}
+
+			if wrapper.IsDiscarded() != tt.wantDiscard {
+				t.Errorf("IsDiscarded() = %v, want %v", wrapper.IsDiscarded(), tt.wantDiscard)
+			}
+		})
+	}
+}
+
+// TestWrapperRegexSepaBic_Components tests the component accessors and branch normalization of WrapperRegexSepaBic.
+func TestWrapperRegexSepaBic_Components(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		appendBranch bool
+		want         string
+		wantBank     string
+		wantCountry  countries.CountryCode
+		wantLocation string
+		wantBranch   string
+	}{
+		{
+			name:         "Primary office",
+			input:        "DEUTDEFF",
+			appendBranch: false,
+			want:         "DEUTDEFF",
+			wantBank:     "DEUT",
+			wantCountry:  countries.DE,
+			wantLocation: "FF",
+			wantBranch:   "XXX",
+		},
+		{
+			name:         "Primary office with appended branch",
+			input:        "DEUTDEFF",
+			appendBranch: true,
+			want:         "DEUTDEFFXXX",
+			wantBank:     "DEUT",
+			wantCountry:  countries.DE,
+			wantLocation: "FF",
+			wantBranch:   "XXX",
+		},
+		{
+			name:         "Branch office",
+			input:        "BNPAFRPP123",
+			appendBranch: true,
+			want:         "BNPAFRPP123",
+			wantBank:     "BNPA",
+			wantCountry:  countries.FR,
+			wantLocation: "PP",
+			wantBranch:   "123",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapper := wrappers.New[*WrapperRegexSepaBic]()
+			wrapper.SetAppendBranch(tt.appendBranch)
+
+			if err := wrapper.Wrap(tt.input, false); err != nil {
+				t.Fatalf("Wrap() error = %v", err)
+			}
+
+			if wrapper.Unwrap() != tt.want {
+				t.Errorf("Unwrap() = %v, want %v", wrapper.Unwrap(), tt.want)
+			}
+			if wrapper.BankCode() != tt.wantBank {
+				t.Errorf("BankCode() = %v, want %v", wrapper.BankCode(), tt.wantBank)
+			}
+			if wrapper.Country() != tt.wantCountry {
+				t.Errorf("Country() = %v, want %v", wrapper.Country(), tt.wantCountry)
+			}
+			if wrapper.Location() != tt.wantLocation {
+				t.Errorf("Location() = %v, want %v", wrapper.Location(), tt.wantLocation)
+			}
+			if wrapper.Branch() != tt.wantBranch {
+				t.Errorf("Branch() = %v, want %v", wrapper.Branch(), tt.wantBranch)
+			}
+		})
+	}
+}
+
+// TestWrapperRegexSepaBic_Iban tests the IBAN country pairing of WrapperRegexSepaBic.
+func TestWrapperRegexSepaBic_Iban(t *testing.T) {
+	tests := []struct {
+		name        string
+		iban        string
+		bic         string
+		wantError   bool
+		wantDiscard bool
+	}{
+		{
+			name:        "Matching country",
+			iban:        "DE89370400440532013000",
+			bic:         "COBADEFFXXX",
+			wantError:   false,
+			wantDiscard: false,
+		},
+		{
+			name:        "Mismatching country",
+			iban:        "FR1420041010050500013M02606",
+			bic:         "COBADEFFXXX",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Discarded IBAN",
+			iban:        "not-an-iban",
+			bic:         "COBADEFFXXX",
+			wantError:   false,
+			wantDiscard: false,
+		},
+		{
+			name:        "IBAN of unknown country",
+			iban:        "QQ89370400440532013000",
+			bic:         "COBADEFFXXX",
+			wantError:   false,
+			wantDiscard: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iban := wrappers.New[*WrapperRegexSepaIban]()
+			if err := iban.Wrap(tt.iban, true); err != nil {
+				t.Fatalf("Wrap() error = %v", err)
+			}
+
+			wrapper := wrappers.New[*WrapperRegexSepaBic]()
+			wrapper.SetIban(iban)
+
+			err := wrapper.Wrap(tt.bic, false)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("Wrap() error = %v, wantError %v", err, tt.wantError)
+			}
+
+			if wrapper.IsDiscarded() != tt.wantDiscard {
+				t.Errorf("IsDiscarded() = %v, want %v", wrapper.IsDiscarded(), tt.wantDiscard)
+			}
+		})
+	}
+}
+
+// TestCheckSepaCountries tests the country check of unmarshalled IBAN and BIC pairs.
+func TestCheckSepaCountries(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantError bool
+	}{
+		{name: "Matching country", input: `{"iban":"DE89370400440532013000","bic":"COBADEFFXXX"}`},
+		{name: "Mismatching country", input: `{"iban":"FR1420041010050500013M02606","bic":"DEUTDEFF"}`, wantError: true},
+		{name: "Missing IBAN", input: `{"bic":"DEUTDEFF"}`},
+		{name: "Null BIC", input: `{"iban":"FR1420041010050500013M02606","bic":null}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var account struct {
+				Iban *WrapperRegexSepaIban `json:"iban"`
+				Bic  *WrapperRegexSepaBic  `json:"bic"`
+			}
+
+			if err := json.Unmarshal([]byte(tt.input), &account); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+
+			if err := CheckSepaCountries(account.Iban, account.Bic); (err != nil) != tt.wantError {
+				t.Errorf("CheckSepaCountries() error = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
@@ -1,10 +1,16 @@
 package regex
 
-import "github.com/zealsprince/wrappers"
+import (
+	"encoding/xml"
+
+	"github.com/biter777/countries"
+	"github.com/zealsprince/wrappers"
+)
 
 const (
 	WrapperRegexSepaIbanName    wrappers.Name = "WrapperRegexSepaIban"
 	WrapperRegexSepaIbanPattern string        = `^[A-Z]{2}[0-9]{2}[A-Z0-9]{1,30}$`
+	WrapperRegexSepaIbanExample string        = "DE89370400440532013000, GB29NWBK60161331926819"
 )
 
 type WrapperRegexSepaIban struct {
@@ -15,3 +21,73 @@ func (wrapper *WrapperRegexSepaIban) Initialize() {
 	wrapper.WrapperRegex.SetPattern(WrapperRegexSepaIbanName, WrapperRegexSepaIbanPattern)
 	wrapper.WrapperBase.Initialize()
 }
+
+// Country returns the country encoded in the first two characters of the IBAN.
+func (wrapper *WrapperRegexSepaIban) Country() countries.CountryCode {
+	if wrapper.IsDiscarded() || len(wrapper.Value) < 2 {
+		return countries.Unknown
+	}
+
+	code := countries.ByName(wrapper.Value[0:2])
+	if code.Alpha2() != wrapper.Value[0:2] {
+		return countries.Unknown
+	}
+
+	return code
+}
+
+// UnmarshalJSON ensures the wrapper is initialized before unmarshalling and proxies the call.
+func (wrapper *WrapperRegexSepaIban) UnmarshalJSON(data []byte) error {
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+	return wrapper.WrapperRegex.UnmarshalJSON(data)
+}
+
+// UnmarshalText ensures the wrapper is initialized before unmarshalling and proxies the call.
+func (wrapper *WrapperRegexSepaIban) UnmarshalText(data []byte) error {
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+	return wrapper.WrapperRegex.UnmarshalText(data)
+}
+
+// Scan ensures the wrapper is initialized before scanning and proxies the call.
+func (wrapper *WrapperRegexSepaIban) Scan(src any) error {
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+	return wrapper.WrapperRegex.Scan(src)
+}
+
+// UnmarshalXML ensures the wrapper is initialized before unmarshalling and proxies the call.
+func (wrapper *WrapperRegexSepaIban) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+	return wrapper.WrapperRegex.UnmarshalXML(decoder, start)
+}
+
+// UnmarshalXMLAttr ensures the wrapper is initialized before unmarshalling and proxies the call.
+func (wrapper *WrapperRegexSepaIban) UnmarshalXMLAttr(attr xml.Attr) error {
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+	return wrapper.WrapperRegex.UnmarshalXMLAttr(attr)
+}
+
+// Set ensures the wrapper is initialized before unmarshalling and proxies the call.
+func (wrapper *WrapperRegexSepaIban) Set(value string) error {
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+	return wrapper.WrapperRegex.Set(value)
+}
+
+// Schema describes the pattern of the wrapper together with its examples.
+func (wrapper *WrapperRegexSepaIban) Schema() wrappers.Schema {
+	schema := wrapper.WrapperRegex.Schema()
+	schema.Examples = wrappers.SchemaExamples(WrapperRegexSepaIbanExample, wrapper)
+
+	return schema
+}
@@ -1,10 +1,15 @@
 package regex
 
-import "github.com/zealsprince/wrappers"
+import (
+	"encoding/xml"
+
+	"github.com/zealsprince/wrappers"
+)
 
 const (
 	WrapperRegexUrlName    wrappers.Name = "WrapperRegexUrl"
 	WrapperRegexUrlPattern string        = `^(https?|ftp)://[^\s/$.?#].[^\s]*$`
+	WrapperRegexUrlExample string        = "https://example.com/path?query=value"
 )
 
 type WrapperRegexUrl struct {
@@ -15,3 +20,59 @@ func (wrapper *WrapperRegexUrl) Initialize() {
 	wrapper.WrapperRegex.SetPattern(WrapperRegexUrlName, WrapperRegexUrlPattern)
 	wrapper.WrapperBase.Initialize()
 }
+
+// UnmarshalJSON ensures the wrapper is initialized before unmarshalling and proxies the call.
+func (wrapper *WrapperRegexUrl) UnmarshalJSON(data []byte) error {
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+	return wrapper.WrapperRegex.UnmarshalJSON(data)
+}
+
+// UnmarshalText ensures the wrapper is initialized before unmarshalling and proxies the call.
+func (wrapper *WrapperRegexUrl) UnmarshalText(data []byte) error {
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+	return wrapper.WrapperRegex.UnmarshalText(data)
+}
+
+// Scan ensures the wrapper is initialized before scanning and proxies the call.
+func (wrapper *WrapperRegexUrl) Scan(src any) error {
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+	return wrapper.WrapperRegex.Scan(src)
+}
+
+// UnmarshalXML ensures the wrapper is initialized before unmarshalling and proxies the call.
+func (wrapper *WrapperRegexUrl) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+	return wrapper.WrapperRegex.UnmarshalXML(decoder, start)
+}
+
+// UnmarshalXMLAttr ensures the wrapper is initialized before unmarshalling and proxies the call.
+func (wrapper *WrapperRegexUrl) UnmarshalXMLAttr(attr xml.Attr) error {
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+	return wrapper.WrapperRegex.UnmarshalXMLAttr(attr)
+}
+
+// Set ensures the wrapper is initialized before unmarshalling and proxies the call.
+func (wrapper *WrapperRegexUrl) Set(value string) error {
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+	return wrapper.WrapperRegex.Set(value)
+}
+
+// Schema describes the pattern of the wrapper together with its examples.
+func (wrapper *WrapperRegexUrl) Schema() wrappers.Schema {
+	schema := wrapper.WrapperRegex.Schema()
+	schema.Examples = wrappers.SchemaExamples(WrapperRegexUrlExample, wrapper)
+
+	return schema
+}
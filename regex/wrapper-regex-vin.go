@@ -1,17 +1,267 @@
 package regex
 
-import "github.com/zealsprince/wrappers"
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/zealsprince/wrappers"
+)
 
 const (
 	WrapperRegexVinName    wrappers.Name = "WrapperRegexVin"
 	WrapperRegexVinPattern string        = `^[A-HJ-NPR-Z0-9]{17}$`
+	WrapperRegexVinExample string        = "1HGCM82633A004352"
+
+	wrapperRegexVinYears string = "ABCDEFGHJKLMNPRSTVWXY123456789" // Model year codes in position 10, starting with 1980 and repeating every 30 years.
+)
+
+// VinCheckDigitPolicy defines when the check digit in position 9 of a VIN is verified.
+type VinCheckDigitPolicy int
+
+const (
+	VinCheckDigitNever        VinCheckDigitPolicy = iota // The check digit is never verified.
+	VinCheckDigitNorthAmerica                            // The check digit is verified for VINs manufactured in North America (WMI starting with 1-5).
+	VinCheckDigitAlways                                  // The check digit is verified for all VINs.
+)
+
+var (
+	wrapperRegexVinWeights = [17]int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+	wrapperRegexVinValues  = map[rune]int{
+		'A': 1, 'B': 2, 'C': 3, 'D': 4, 'E': 5, 'F': 6, 'G': 7, 'H': 8,
+		'J': 1, 'K': 2, 'L': 3, 'M': 4, 'N': 5, 'P': 7, 'R': 9,
+		'S': 2, 'T': 3, 'U': 4, 'V': 5, 'W': 6, 'X': 7, 'Y': 8, 'Z': 9,
+	}
 )
 
 type WrapperRegexVin struct {
 	WrapperRegex
+	checkDigitPolicy VinCheckDigitPolicy
 }
 
 func (wrapper *WrapperRegexVin) Initialize() {
 	wrapper.WrapperRegex.SetPattern(WrapperRegexVinName, WrapperRegexVinPattern)
 	wrapper.WrapperBase.Initialize()
 }
+
+// SetCheckDigitPolicy configures when the check digit of a VIN is verified during wrapping. Defaults to VinCheckDigitNever.
+func (wrapper *WrapperRegexVin) SetCheckDigitPolicy(policy VinCheckDigitPolicy) {
+	wrapper.checkDigitPolicy = policy
+}
+
+// VinCheckDigit calculates the check digit of a 17 character VIN. A remainder of 10 is represented as "X".
+func VinCheckDigit(vin string) (byte, error) {
+	if len(vin) != 17 {
+		return 0, fmt.Errorf("expected 17 characters, got %d", len(vin))
+	}
+
+	sum := 0
+	for i, char := range vin {
+		value, ok := wrapperRegexVinValues[char]
+		if !ok {
+			if char < '0' || char > '9' {
+				return 0, fmt.Errorf("invalid character %q at position %d", char, i+1)
+			}
+			value = int(char - '0')
+		}
+
+		sum += value * wrapperRegexVinWeights[i]
+	}
+
+	remainder := sum % 11
+	if remainder == 10 {
+		return 'X', nil
+	}
+
+	return byte('0' + remainder), nil
+}
+
+// Wrap validates the value against the VIN pattern and verifies the check digit according to the configured policy.
+func (wrapper *WrapperRegexVin) Wrap(value any, discard bool) error {
+	if err := wrapper.WrapperRegex.Wrap(value, discard); err != nil {
+		return err
+	}
+
+	// The regex wrapper already discarded the value (and reported if necessary).
+	if wrapper.IsDiscarded() {
+		return nil
+	}
+
+	vin := wrapper.Value
+
+	verify := wrapper.checkDigitPolicy == VinCheckDigitAlways ||
+		(wrapper.checkDigitPolicy == VinCheckDigitNorthAmerica && strings.ContainsRune("12345", rune(vin[0])))
+
+	if verify {
+		digit, err := VinCheckDigit(vin)
+		if err != nil {
+			wrapper.Discard()
+			if !discard {
+				return wrappers.ErrorParse(WrapperRegexVinName, vin, err)
+			}
+			return nil
+		}
+
+		if vin[8] != digit {
+			wrapper.Discard()
+			if !discard {
+				return wrappers.ErrorValue(WrapperRegexVinName, vin, fmt.Sprintf("check digit %c in position 9", digit))
+			}
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// WMI returns the world manufacturer identifier in positions 1-3.
+func (wrapper *WrapperRegexVin) WMI() string {
+	if wrapper.IsDiscarded() || len(wrapper.Value) != 17 {
+		return ""
+	}
+
+	return wrapper.Value[0:3]
+}
+
+// VDS returns the vehicle descriptor section in positions 4-9, including the check digit.
+func (wrapper *WrapperRegexVin) VDS() string {
+	if wrapper.IsDiscarded() || len(wrapper.Value) != 17 {
+		return ""
+	}
+
+	return wrapper.Value[3:9]
+}
+
+// VIS returns the vehicle identifier section in positions 10-17.
+func (wrapper *WrapperRegexVin) VIS() string {
+	if wrapper.IsDiscarded() || len(wrapper.Value) != 17 {
+		return ""
+	}
+
+	return wrapper.Value[9:17]
+}
+
+// Plant returns the plant code in position 11.
+func (wrapper *WrapperRegexVin) Plant() string {
+	if wrapper.IsDiscarded() || len(wrapper.Value) != 17 {
+		return ""
+	}
+
+	return wrapper.Value[10:11]
+}
+
+// Serial returns the production sequence number in positions 12-17.
+func (wrapper *WrapperRegexVin) Serial() string {
+	if wrapper.IsDiscarded() || len(wrapper.Value) != 17 {
+		return ""
+	}
+
+	return wrapper.Value[11:17]
+}
+
+// ModelYear decodes the model year from position 10. As the codes repeat every 30 years, position 7 is used to select the cycle:
+// a digit indicates 1980-2009 and a letter indicates 2010-2039, following the North American convention.
+// Returns 0 if the model year can not be decoded.
+func (wrapper *WrapperRegexVin) ModelYear() int {
+	if wrapper.IsDiscarded() || len(wrapper.Value) != 17 {
+		return 0
+	}
+
+	index := strings.IndexByte(wrapperRegexVinYears, wrapper.Value[9])
+	if index < 0 {
+		return 0
+	}
+
+	year := 1980 + index
+	if wrapper.Value[6] < '0' || wrapper.Value[6] > '9' {
+		year += 30
+	}
+
+	return year
+}
+
+// UnmarshalJSON ensures the wrapper is initialized before unmarshalling and verifies the check digit.
+func (wrapper *WrapperRegexVin) UnmarshalJSON(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+
+	return wrappers.UnmarshalJSON(data, wrapper)
+}
+
+// UnmarshalText ensures the wrapper is initialized before unmarshalling and verifies the check digit.
+func (wrapper *WrapperRegexVin) UnmarshalText(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+
+	return wrappers.UnmarshalText(data, wrapper)
+}
+
+// Scan ensures the wrapper is initialized before scanning and verifies the check digit.
+func (wrapper *WrapperRegexVin) Scan(src any) error {
+	if wrapper == nil {
+		return fmt.Errorf("scan into nil wrapper")
+	}
+
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+
+	return wrappers.Scan(src, wrapper)
+}
+
+// UnmarshalXML ensures the wrapper is initialized before unmarshalling and verifies the check digit.
+func (wrapper *WrapperRegexVin) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+
+	return wrappers.UnmarshalXML(decoder, start, wrapper)
+}
+
+// UnmarshalXMLAttr ensures the wrapper is initialized before unmarshalling and verifies the check digit.
+func (wrapper *WrapperRegexVin) UnmarshalXMLAttr(attr xml.Attr) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+
+	return wrappers.UnmarshalXMLAttr(attr, wrapper)
+}
+
+// Set ensures the wrapper is initialized before unmarshalling and verifies the check digit.
+func (wrapper *WrapperRegexVin) Set(value string) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+
+	return wrappers.Set(value, wrapper)
+}
+
+// Schema describes the pattern of the wrapper together with its examples.
+func (wrapper *WrapperRegexVin) Schema() wrappers.Schema {
+	schema := wrapper.WrapperRegex.Schema()
+	schema.Examples = wrappers.SchemaExamples(WrapperRegexVinExample, wrapper)
+
+	return schema
+}
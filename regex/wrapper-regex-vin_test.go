@@ -255,3 +255,148 @@ func TestWrapperRegexVin_Unmarshal(t *testing.T) {
 		})
 	}
 }
+
+// TestWrapperRegexVin_CheckDigit tests the check digit verification of WrapperRegexVin.
+func TestWrapperRegexVin_CheckDigit(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		policy      VinCheckDigitPolicy
+		discard     bool
+		wantError   bool
+		wantDiscard bool
+	}{
+		{
+			name:        "Valid check digit X",
+			input:       "1M8GDM9AXKP042788",
+			policy:      VinCheckDigitAlways,
+			wantError:   false,
+			wantDiscard: false,
+		},
+		{
+			name:        "Valid check digit",
+			input:       "1HGCM82633A004352",
+			policy:      VinCheckDigitNorthAmerica,
+			wantError:   false,
+			wantDiscard: false,
+		},
+		{
+			name:        "Transposed characters",
+			input:       "1HGCM82633A003452",
+			policy:      VinCheckDigitNorthAmerica,
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Transposed characters discard",
+			input:       "1HGCM82633A003452",
+			policy:      VinCheckDigitNorthAmerica,
+			discard:     true,
+			wantError:   false,
+			wantDiscard: true,
+		},
+		{
+			name:        "Invalid check digit outside of North America",
+			input:       "WVWZZZ1JZXW000001",
+			policy:      VinCheckDigitNorthAmerica,
+			wantError:   false,
+			wantDiscard: false,
+		},
+		{
+			name:        "Invalid check digit outside of North America always verified",
+			input:       "WVWZZZ1JZXW000001",
+			policy:      VinCheckDigitAlways,
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Invalid check digit never verified",
+			input:       "1HGCM82633A003452",
+			policy:      VinCheckDigitNever,
+			wantError:   false,
+			wantDiscard: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapper := wrappers.New[*WrapperRegexVin]()
+			wrapper.SetCheckDigitPolicy(tt.policy)
+
+			err := wrapper.Wrap(tt.input, tt.discard)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("Wrap() error = %v, wantError %v", err, tt.wantError)
+			}
+
+			if wrapper.IsDiscarded() != tt.wantDiscard {
+				t.Errorf("IsDiscarded() = %v, want %v", wrapper.IsDiscarded(), tt.wantDiscard)
+			}
+		})
+	}
+}
+
+// TestWrapperRegexVin_Decode tests the decoding accessors of WrapperRegexVin.
+func TestWrapperRegexVin_Decode(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		wantWMI       string
+		wantVDS       string
+		wantPlant     string
+		wantSerial    string
+		wantModelYear int
+	}{
+		{
+			name:          "Model year in the 1980-2009 cycle",
+			input:         "1M8GDM9AXKP042788",
+			wantWMI:       "1M8",
+			wantVDS:       "GDM9AX",
+			wantPlant:     "P",
+			wantSerial:    "042788",
+			wantModelYear: 1989,
+		},
+		{
+			name:          "Model year with numeric code",
+			input:         "1HGCM82633A004352",
+			wantWMI:       "1HG",
+			wantVDS:       "CM8263",
+			wantPlant:     "A",
+			wantSerial:    "004352",
+			wantModelYear: 2003,
+		},
+		{
+			name:          "Model year in the 2010-2039 cycle",
+			input:         "5YJ3E1EA7PF000001",
+			wantWMI:       "5YJ",
+			wantVDS:       "3E1EA7",
+			wantPlant:     "F",
+			wantSerial:    "000001",
+			wantModelYear: 2023,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapper, err := wrappers.NewWithValue[*WrapperRegexVin](tt.input)
+			if err != nil {
+				t.Fatalf("NewWithValue() error = %v", err)
+			}
+
+			if wrapper.WMI() != tt.wantWMI {
+				t.Errorf("WMI() = %v, want %v", wrapper.WMI(), tt.wantWMI)
+			}
+			if wrapper.VDS() != tt.wantVDS {
+				t.Errorf("VDS() = %v, want %v", wrapper.VDS(), tt.wantVDS)
+			}
+			if wrapper.Plant() != tt.wantPlant {
+				t.Errorf("Plant() = %v, want %v", wrapper.Plant(), tt.wantPlant)
+			}
+			if wrapper.Serial() != tt.wantSerial {
+				t.Errorf("Serial() = %v, want %v", wrapper.Serial(), tt.wantSerial)
+			}
+			if wrapper.ModelYear() != tt.wantModelYear {
+				t.Errorf("ModelYear() = %v, want %v", wrapper.ModelYear(), tt.wantModelYear)
+			}
+		})
+	}
+}
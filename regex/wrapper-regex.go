@@ -1,6 +1,7 @@
 package regex
 
 import (
+	"encoding/xml"
 	"fmt"
 	"regexp"
 	"sync"
@@ -143,3 +144,66 @@ func (wrapper *WrapperRegex) UnmarshalJSON(data []byte) error {
 
 	return wrappers.UnmarshalJSON(data, wrapper)
 }
+
+// MarshalText marshals the wrapped value to text, handling discards.
+func (wrapper *WrapperRegex) MarshalText() ([]byte, error) {
+	return wrappers.MarshalText(wrapper)
+}
+
+// UnmarshalText unmarshals text into the wrapper.
+func (wrapper *WrapperRegex) UnmarshalText(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return wrappers.UnmarshalText(data, wrapper)
+}
+
+func (wrapper *WrapperRegex) Scan(src any) error {
+	if wrapper == nil {
+		return fmt.Errorf("scan into nil wrapper")
+	}
+
+	return wrappers.Scan(src, wrapper)
+}
+
+func (wrapper *WrapperRegex) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return wrappers.MarshalXML(encoder, start, wrapper)
+}
+
+func (wrapper *WrapperRegex) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return wrappers.UnmarshalXML(decoder, start, wrapper)
+}
+
+func (wrapper *WrapperRegex) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return wrappers.MarshalXMLAttr(name, wrapper)
+}
+
+func (wrapper *WrapperRegex) UnmarshalXMLAttr(attr xml.Attr) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return wrappers.UnmarshalXMLAttr(attr, wrapper)
+}
+
+func (wrapper *WrapperRegex) Set(value string) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return wrappers.Set(value, wrapper)
+}
+
+func (wrapper *WrapperRegex) String() string {
+	return wrappers.String(wrapper)
+}
+
+// Schema describes strings matching the pattern of the wrapper.
+func (wrapper *WrapperRegex) Schema() wrappers.Schema {
+	return wrappers.Schema{Type: "string", Pattern: wrapper.pattern}
+}
@@ -0,0 +1,242 @@
+package regex
+
+import (
+	"database/sql"
+	"encoding"
+	"encoding/json"
+	"encoding/xml"
+	"flag"
+	"regexp"
+	"testing"
+
+	"github.com/zealsprince/wrappers"
+)
+
+// standardWrapper is implemented by the regex wrappers in addition to WrapperProvider.
+type standardWrapper interface {
+	wrappers.WrapperProvider
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+	sql.Scanner
+	xml.Marshaler
+	xml.Unmarshaler
+	xml.MarshalerAttr
+	xml.UnmarshalerAttr
+	flag.Value
+	wrappers.SchemaProvider
+}
+
+var _ = []standardWrapper{
+	(*WrapperRegex)(nil), (*WrapperRegexEmail)(nil), (*WrapperRegexPhone)(nil), (*WrapperRegexSepaBic)(nil),
+	(*WrapperRegexSepaIban)(nil), (*WrapperRegexUrl)(nil), (*WrapperRegexVin)(nil),
+}
+
+// TestWrapperRegex_Text tests text marshalling and unmarshalling of the derived regex wrappers without prior initialization.
+func TestWrapperRegex_Text(t *testing.T) {
+	tests := []struct {
+		name        string
+		wrapper     standardWrapper
+		input       string
+		want        string
+		wantError   bool
+		wantDiscard bool
+	}{
+		{name: "WrapperRegexEmail", wrapper: &WrapperRegexEmail{}, input: "valid@email.com", want: "valid@email.com"},
+		{name: "WrapperRegexEmail invalid", wrapper: &WrapperRegexEmail{}, input: "invalid-email", wantError: true, wantDiscard: true},
+		{name: "WrapperRegexPhone", wrapper: &WrapperRegexPhone{}, input: "+4915123456789", want: "+4915123456789"},
+		{name: "WrapperRegexUrl", wrapper: &WrapperRegexUrl{}, input: "https://example.com", want: "https://example.com"},
+		{name: "WrapperRegexSepaIban", wrapper: &WrapperRegexSepaIban{}, input: "DE89370400440532013000", want: "DE89370400440532013000"},
+		{name: "WrapperRegexSepaBic", wrapper: &WrapperRegexSepaBic{}, input: "DEUTDEFF", want: "DEUTDEFF"},
+		{name: "WrapperRegexSepaBic invalid country", wrapper: &WrapperRegexSepaBic{}, input: "DEUTQQFF", wantError: true, wantDiscard: true},
+		{name: "WrapperRegexVin", wrapper: &WrapperRegexVin{}, input: "1M8GDM9AXKP042788", want: "1M8GDM9AXKP042788"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.wrapper.UnmarshalText([]byte(tt.input))
+			if (err != nil) != tt.wantError {
+				t.Fatalf("UnmarshalText() error = %v, wantError %v", err, tt.wantError)
+			}
+
+			if tt.wrapper.IsDiscarded() != tt.wantDiscard {
+				t.Errorf("IsDiscarded() = %v, want %v", tt.wrapper.IsDiscarded(), tt.wantDiscard)
+			}
+
+			text, err := tt.wrapper.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText() error = %v", err)
+			}
+
+			if string(text) != tt.want {
+				t.Errorf("MarshalText() = %q, want %q", string(text), tt.want)
+			}
+		})
+	}
+}
+
+// TestWrapperRegex_UnmarshalUninitialized tests that derived regex wrappers allocated during JSON unmarshalling are initialized.
+func TestWrapperRegex_UnmarshalUninitialized(t *testing.T) {
+	type Data struct {
+		Iban *WrapperRegexSepaIban `json:"iban"`
+		Url  *WrapperRegexUrl      `json:"url"`
+	}
+
+	var data Data
+	if err := json.Unmarshal([]byte(`{"iban":"DE89370400440532013000","url":"https://example.com"}`), &data); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if data.Iban.Get() != "DE89370400440532013000" || data.Url.Get() != "https://example.com" {
+		t.Errorf("Get() = %v, %v", data.Iban.Get(), data.Url.Get())
+	}
+}
+
+// TestWrapperRegex_Scan tests scanning database values into the derived regex wrappers without prior initialization.
+func TestWrapperRegex_Scan(t *testing.T) {
+	tests := []struct {
+		name        string
+		wrapper     standardWrapper
+		src         any
+		want        any
+		wantError   bool
+		wantDiscard bool
+	}{
+		{name: "WrapperRegexEmail", wrapper: &WrapperRegexEmail{}, src: "valid@email.com", want: "valid@email.com"},
+		{name: "WrapperRegexEmail bytes", wrapper: &WrapperRegexEmail{}, src: []byte("valid@email.com"), want: "valid@email.com"},
+		{name: "WrapperRegexEmail invalid", wrapper: &WrapperRegexEmail{}, src: "invalid-email", wantError: true, wantDiscard: true},
+		{name: "WrapperRegexEmail NULL", wrapper: &WrapperRegexEmail{}, src: nil, wantDiscard: true},
+		{name: "WrapperRegexPhone", wrapper: &WrapperRegexPhone{}, src: "+4915123456789", want: "+4915123456789"},
+		{name: "WrapperRegexUrl", wrapper: &WrapperRegexUrl{}, src: "https://example.com", want: "https://example.com"},
+		{name: "WrapperRegexSepaIban", wrapper: &WrapperRegexSepaIban{}, src: "DE89370400440532013000", want: "DE89370400440532013000"},
+		{name: "WrapperRegexSepaBic", wrapper: &WrapperRegexSepaBic{}, src: "DEUTDEFF", want: "DEUTDEFF"},
+		{name: "WrapperRegexSepaBic invalid country", wrapper: &WrapperRegexSepaBic{}, src: "DEUTQQFF", wantError: true, wantDiscard: true},
+		{name: "WrapperRegexVin", wrapper: &WrapperRegexVin{}, src: "1M8GDM9AXKP042788", want: "1M8GDM9AXKP042788"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.wrapper.Scan(tt.src)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("Scan() error = %v, wantError %v", err, tt.wantError)
+			}
+
+			if tt.wrapper.IsDiscarded() != tt.wantDiscard {
+				t.Errorf("IsDiscarded() = %v, want %v", tt.wrapper.IsDiscarded(), tt.wantDiscard)
+			}
+
+			value, err := wrappers.DriverValue(tt.wrapper)
+			if err != nil {
+				t.Fatalf("DriverValue() error = %v", err)
+			}
+
+			if value != tt.want {
+				t.Errorf("DriverValue() = %v, want %v", value, tt.want)
+			}
+		})
+	}
+}
+
+// TestWrapperRegex_XML tests unmarshalling a SEPA credit transfer fragment into the derived regex wrappers without prior initialization.
+func TestWrapperRegex_XML(t *testing.T) {
+	type Account struct {
+		Iban *WrapperRegexSepaIban `xml:"Id>IBAN"`
+	}
+
+	type Agent struct {
+		Bic *WrapperRegexSepaBic `xml:"FinInstnId>BICFI"`
+	}
+
+	type Transaction struct {
+		XMLName       xml.Name `xml:"CdtTrfTxInf"`
+		CreditorAgent Agent    `xml:"CdtrAgt"`
+		Creditor      Account  `xml:"CdtrAcct"`
+	}
+
+	tests := []struct {
+		name      string
+		input     string
+		wantIban  string
+		wantBic   string
+		wantError bool
+	}{
+		{
+			name:     "Valid transaction",
+			input:    `<CdtTrfTxInf><CdtrAgt><FinInstnId><BICFI>DEUTDEFF</BICFI></FinInstnId></CdtrAgt><CdtrAcct><Id><IBAN>DE89370400440532013000</IBAN></Id></CdtrAcct></CdtTrfTxInf>`,
+			wantIban: "DE89370400440532013000",
+			wantBic:  "DEUTDEFF",
+		},
+		{
+			name:      "Invalid IBAN",
+			input:     `<CdtTrfTxInf><CdtrAgt><FinInstnId><BICFI>DEUTDEFF</BICFI></FinInstnId></CdtrAgt><CdtrAcct><Id><IBAN>DE89 3704 0044</IBAN></Id></CdtrAcct></CdtTrfTxInf>`,
+			wantError: true,
+		},
+		{
+			name:      "Invalid BIC country",
+			input:     `<CdtTrfTxInf><CdtrAgt><FinInstnId><BICFI>DEUTQQFF</BICFI></FinInstnId></CdtrAgt></CdtTrfTxInf>`,
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var transaction Transaction
+			err := xml.Unmarshal([]byte(tt.input), &transaction)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("Unmarshal() error = %v, wantError %v", err, tt.wantError)
+			}
+
+			if tt.wantError {
+				return
+			}
+
+			if transaction.Creditor.Iban.Get() != tt.wantIban || transaction.CreditorAgent.Bic.Get() != tt.wantBic {
+				t.Errorf("Get() = %v, %v, want %v, %v", transaction.Creditor.Iban.Get(), transaction.CreditorAgent.Bic.Get(), tt.wantIban, tt.wantBic)
+			}
+
+			output, err := xml.Marshal(&transaction)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+
+			if string(output) != tt.input {
+				t.Errorf("Marshal() = %s, want %s", output, tt.input)
+			}
+		})
+	}
+}
+
+// TestWrapperRegex_Schema tests that the derived regex wrappers describe their pattern and that their examples match it.
+func TestWrapperRegex_Schema(t *testing.T) {
+	tests := []struct {
+		name    string
+		wrapper standardWrapper
+		pattern string
+	}{
+		{name: "WrapperRegexEmail", wrapper: wrappers.New[*WrapperRegexEmail](), pattern: WrapperRegexEmailPattern},
+		{name: "WrapperRegexPhone", wrapper: wrappers.New[*WrapperRegexPhone](), pattern: WrapperRegexPhonePattern},
+		{name: "WrapperRegexSepaBic", wrapper: wrappers.New[*WrapperRegexSepaBic](), pattern: WrapperRegexSepaBicPattern},
+		{name: "WrapperRegexSepaIban", wrapper: wrappers.New[*WrapperRegexSepaIban](), pattern: WrapperRegexSepaIbanPattern},
+		{name: "WrapperRegexUrl", wrapper: wrappers.New[*WrapperRegexUrl](), pattern: WrapperRegexUrlPattern},
+		{name: "WrapperRegexVin", wrapper: wrappers.New[*WrapperRegexVin](), pattern: WrapperRegexVinPattern},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema := tt.wrapper.Schema()
+			if schema.Type != "string" || schema.Pattern != tt.pattern {
+				t.Errorf("Schema() = %+v, want pattern %q", schema, tt.pattern)
+			}
+
+			if len(schema.Examples) == 0 {
+				t.Fatalf("Schema().Examples is empty")
+			}
+
+			for _, example := range schema.Examples {
+				var value string
+				if err := json.Unmarshal(example.(json.RawMessage), &value); err != nil || !regexp.MustCompile(tt.pattern).MatchString(value) {
+					t.Errorf("example %s does not match the pattern", example)
+				}
+			}
+		})
+	}
+}
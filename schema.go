@@ -0,0 +1,69 @@
+package wrappers
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// Schema is a JSON Schema (draft 2020-12) fragment describing the JSON values of a wrapper.
+// It describes the canonical form wrappers marshal to, which is always accepted when unmarshalling as well.
+// The schema sub-package assembles these fragments into schemas of whole structs.
+type Schema struct {
+	Type     string      // JSON type, i.e. "string", "integer", "number" or "boolean".
+	Nullable bool        // If true, null is a valid value as well.
+	Format   string      // Format of strings, e.g. "date-time", "date", "email" or "uri".
+	Pattern  string      // Regular expression strings have to match.
+	Enum     []any       // The only valid values, if set.
+	Minimum  json.Number // Inclusive lower bound of numbers, if set.
+	Maximum  json.Number // Inclusive upper bound of numbers, if set.
+	Examples []any       // Example values in their canonical form, see SchemaExamples.
+}
+
+// SchemaProvider is implemented by types describing their JSON values with a Schema. This includes all wrappers and Discarder.
+type SchemaProvider interface {
+	Schema() Schema
+}
+
+// SchemaOf describes the JSON values of the wrapper with its Schema method if it implements SchemaProvider.
+// Other wrappers are described by an empty schema, which accepts any value.
+func SchemaOf(wrapper WrapperProvider) Schema {
+	if provider, ok := wrapper.(SchemaProvider); ok {
+		return provider.Schema()
+	}
+
+	return Schema{}
+}
+
+// SchemaExamples converts the comma separated examples of a wrapper, such as WrapperBoolExample, into their canonical JSON values.
+// All wrappers with examples should call this method in their Schema implementation. Each example is wrapped by a copy of the wrapper,
+// so its configuration applies, e.g. the "yes" example of WrapperBool becomes true. Invalid examples and duplicates are left out.
+func SchemaExamples(example string, wrapper WrapperProvider) []any {
+	value := reflect.ValueOf(wrapper)
+	if value.Kind() != reflect.Pointer || value.IsNil() {
+		return nil
+	}
+
+	var examples []any
+	seen := make(map[string]bool)
+
+	for _, input := range strings.Split(example, ", ") {
+		clone := reflect.New(value.Elem().Type())
+		clone.Elem().Set(value.Elem())
+
+		candidate := clone.Interface().(WrapperProvider)
+		if err := wrap(candidate, input); err != nil || candidate.IsDiscarded() {
+			continue
+		}
+
+		data, err := candidate.MarshalJSON()
+		if err != nil || seen[string(data)] {
+			continue
+		}
+
+		seen[string(data)] = true
+		examples = append(examples, json.RawMessage(data))
+	}
+
+	return examples
+}
@@ -0,0 +1,270 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zealsprince/wrappers"
+	"github.com/zealsprince/wrappers/internal/fields"
+)
+
+var (
+	providerType = reflect.TypeOf((*wrappers.SchemaProvider)(nil)).Elem()
+	wrapperType  = reflect.TypeOf((*wrappers.WrapperProvider)(nil)).Elem()
+	timeType     = reflect.TypeOf(time.Time{})
+	numberType   = reflect.TypeOf(json.Number(""))
+	rawType      = reflect.TypeOf(json.RawMessage(nil))
+)
+
+// unsafeName matches characters that are replaced in the names of definitions, e.g. the brackets of generic types.
+var unsafeName = regexp.MustCompile(`[^A-Za-z0-9_.\-]+`)
+
+// Generator generates schemas of types. Named structs are collected as definitions and referenced, so a generator can be shared
+// between multiple types to describe them with a single set of definitions.
+type Generator struct {
+	prefix      string                  // Prefix of references to definitions.
+	definitions map[string]*Schema      // Schemas of named structs by name.
+	names       map[reflect.Type]string // Names of the definitions by type.
+	references  map[string]int          // Number of references to each definition.
+}
+
+// NewGenerator creates a generator referencing definitions with DefinitionsRef.
+func NewGenerator() *Generator {
+	return &Generator{
+		prefix:      DefinitionsRef,
+		definitions: make(map[string]*Schema),
+		names:       make(map[reflect.Type]string),
+		references:  make(map[string]int),
+	}
+}
+
+// SetRefPrefix sets the prefix of references to definitions, e.g. "#/components/schemas/". Defaults to DefinitionsRef.
+func (generator *Generator) SetRefPrefix(prefix string) {
+	generator.prefix = prefix
+}
+
+// Definitions returns the schemas of all named structs encountered so far by name.
+func (generator *Generator) Definitions() map[string]*Schema {
+	return generator.definitions
+}
+
+// Schema generates the schema of the type. Named structs are returned as references to their definitions.
+//   - Wrappers and other types implementing SchemaProvider, such as Discarder, are described by their Schema method.
+//     Wrappers without a Schema method accept any value.
+//   - Struct fields follow the rules of encoding/json. Fields without the omitempty or omitzero option are required.
+//     Pointers to wrappers are nullable. Descriptions are read from the description tag.
+//   - Pointers are described by the type they point to. Byte slices are described as base64 strings.
+//
+// Types that cannot be marshalled to JSON, such as channels and functions, result in an error.
+func (generator *Generator) Schema(t reflect.Type) (*Schema, error) {
+	if schema, ok := describe(t); ok {
+		return fragment(schema), nil
+	}
+
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}, nil
+
+	case numberType:
+		return &Schema{Type: "number"}, nil
+
+	case rawType:
+		return &Schema{}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return generator.Schema(t.Elem())
+
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}, nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &Schema{Type: "integer"}, nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return &Schema{Type: "integer", Minimum: "0"}, nil
+
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}, nil
+
+	case reflect.String:
+		return &Schema{Type: "string"}, nil
+
+	case reflect.Interface:
+		return &Schema{}, nil // Any value.
+
+	case reflect.Slice, reflect.Array:
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", ContentEncoding: "base64"}, nil
+		}
+
+		items, err := generator.Schema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		return &Schema{Type: "array", Items: items}, nil
+
+	case reflect.Map:
+		values, err := generator.Schema(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		return &Schema{Type: "object", AdditionalProperties: values}, nil
+
+	case reflect.Struct:
+		if t.Name() == "" {
+			return generator.object(t)
+		}
+
+		return generator.reference(t)
+	}
+
+	return nil, fmt.Errorf("unsupported type %s", t)
+}
+
+// reference returns a reference to the definition of the named struct, generating the definition first if necessary.
+func (generator *Generator) reference(t reflect.Type) (*Schema, error) {
+	name, ok := generator.names[t]
+	if !ok {
+		name = generator.name(t)
+
+		// The definition is registered before generating it, so structs referencing themselves resolve to it.
+		definition := &Schema{}
+		generator.names[t] = name
+		generator.definitions[name] = definition
+
+		object, err := generator.object(t)
+		if err != nil {
+			delete(generator.names, t)
+			delete(generator.definitions, name)
+			return nil, err
+		}
+
+		*definition = *object
+	}
+
+	generator.references[name]++
+
+	return &Schema{Ref: generator.prefix + name}, nil
+}
+
+// name derives a unique name of a definition from the name of the type.
+func (generator *Generator) name(t reflect.Type) string {
+	base := unsafeName.ReplaceAllString(t.Name(), "_")
+
+	name := base
+	for i := 2; ; i++ {
+		if _, ok := generator.definitions[name]; !ok {
+			return name
+		}
+
+		name = base + strconv.Itoa(i)
+	}
+}
+
+// object generates the schema of the struct.
+func (generator *Generator) object(t reflect.Type) (*Schema, error) {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+
+	if err := generator.properties(t, schema); err != nil {
+		return nil, err
+	}
+
+	return schema, nil
+}
+
+// properties adds the fields of the struct to the properties of the schema.
+func (generator *Generator) properties(t reflect.Type, schema *Schema) error {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		tag := field.Tag.Get(TagName)
+		if tag == "-" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+
+		// Like encoding/json, the fields of embedded structs without a name are promoted into the enclosing struct.
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+
+			if _, ok := describe(field.Type); !ok && embedded.Kind() == reflect.Struct {
+				if err := generator.properties(embedded, schema); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		property, err := generator.field(field.Type)
+		if err != nil {
+			return fmt.Errorf("field %s: %w", field.Name, err)
+		}
+
+		if description, ok := field.Tag.Lookup(TagDescription); ok {
+			property.Description = description
+		}
+
+		schema.Properties[name] = property
+
+		if !fields.HasOption(tag, "omitempty") && !fields.HasOption(tag, "omitzero") {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+
+	return nil
+}
+
+// field generates the schema of a struct field. Pointers to wrappers are nullable, as nil pointers are marshalled as null.
+func (generator *Generator) field(t reflect.Type) (*Schema, error) {
+	if t.Kind() == reflect.Pointer {
+		if described, ok := describe(t); ok {
+			described.Nullable = true
+			return fragment(described), nil
+		}
+	}
+
+	return generator.Schema(t)
+}
+
+// describe describes an initialized value of the type if it implements SchemaProvider or WrapperProvider, either directly or through a pointer.
+// Wrappers without a Schema method are described by wrappers.SchemaOf.
+func describe(t reflect.Type) (wrappers.Schema, bool) {
+	if t.Kind() == reflect.Pointer {
+		if !t.Implements(providerType) && !t.Implements(wrapperType) {
+			return wrappers.Schema{}, false
+		}
+
+		t = t.Elem()
+	} else if pointer := reflect.PointerTo(t); !pointer.Implements(providerType) && !pointer.Implements(wrapperType) {
+		return wrappers.Schema{}, false
+	}
+
+	value := reflect.New(t).Interface()
+	if wrapper, ok := value.(wrappers.WrapperProvider); ok {
+		wrapper.Initialize()
+		return wrappers.SchemaOf(wrapper), true
+	}
+
+	return value.(wrappers.SchemaProvider).Schema(), true
+}
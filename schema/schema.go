@@ -0,0 +1,91 @@
+// Package schema generates JSON Schemas (draft 2020-12) from structs of wrappers.
+package schema
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/zealsprince/wrappers"
+)
+
+const (
+	Draft          = "https://json-schema.org/draft/2020-12/schema" // Dialect of generated schemas.
+	TagName        = "json"                                         // Struct tag holding the property name and options, as used by encoding/json.
+	TagDescription = "description"                                  // Struct tag holding the description of a property.
+	DefinitionsRef = "#/$defs/"                                     // Default prefix of references to named structs.
+)
+
+// Schema is a JSON Schema. Only the keywords used by the generator are supported.
+type Schema struct {
+	Dialect              string             `json:"$schema,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Type                 any                `json:"type,omitempty"` // A type name or, if the schema is nullable, a list of type names.
+	Format               string             `json:"format,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	ContentEncoding      string             `json:"contentEncoding,omitempty"`
+	Enum                 []any              `json:"enum,omitempty"`
+	Minimum              json.Number        `json:"minimum,omitempty"`
+	Maximum              json.Number        `json:"maximum,omitempty"`
+	Examples             []any              `json:"examples,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Definitions          map[string]*Schema `json:"$defs,omitempty"`
+}
+
+// Generate generates the schema document of the type, commonly a struct of wrappers.
+// Named structs other than the type itself are referenced from the "$defs" of the document.
+func Generate(t reflect.Type) (*Schema, error) {
+	generator := NewGenerator()
+
+	schema, err := generator.Schema(t)
+	if err != nil {
+		return nil, err
+	}
+
+	// The definition of a named struct is inlined into the document unless the struct references itself.
+	if name, ok := strings.CutPrefix(schema.Ref, DefinitionsRef); ok {
+		definition := *generator.definitions[name]
+		schema = &definition
+
+		if generator.references[name] == 1 {
+			delete(generator.definitions, name)
+		}
+	}
+
+	schema.Dialect = Draft
+	if len(generator.definitions) > 0 {
+		schema.Definitions = generator.definitions
+	}
+
+	return schema, nil
+}
+
+// fragment converts the schema of a wrapper. Nullable schemas list null as an additional type and valid value.
+func fragment(source wrappers.Schema) *Schema {
+	schema := &Schema{
+		Format:   source.Format,
+		Pattern:  source.Pattern,
+		Enum:     source.Enum,
+		Minimum:  source.Minimum,
+		Maximum:  source.Maximum,
+		Examples: source.Examples,
+	}
+
+	// Schemas without a type, e.g. of wrappers without a Schema method, accept any value including null.
+	if source.Type != "" {
+		schema.Type = source.Type
+		if source.Nullable {
+			schema.Type = []string{source.Type, "null"}
+		}
+	}
+
+	if source.Nullable && schema.Enum != nil {
+		schema.Enum = append(schema.Enum[:len(schema.Enum):len(schema.Enum)], nil)
+	}
+
+	return schema
+}
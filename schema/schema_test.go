@@ -0,0 +1,230 @@
+package schema
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/zealsprince/wrappers"
+	"github.com/zealsprince/wrappers/enum"
+	"github.com/zealsprince/wrappers/regex"
+)
+
+type address struct {
+	City    *wrappers.WrapperString  `json:"city"`
+	Country *wrappers.WrapperCountry `json:"country"`
+}
+
+type audit struct {
+	Created *wrappers.WrapperTime `json:"created"`
+}
+
+type customer struct {
+	audit
+	ID        *wrappers.WrapperInt                       `json:"id"`
+	Email     *regex.WrapperRegexEmail                   `json:"email"`
+	Direction *enum.WrapperEnumCardinalDirections        `json:"direction,omitempty"`
+	Birthday  *wrappers.Discarder[*wrappers.WrapperDate] `json:"birthday"`
+	Tags      []*wrappers.WrapperString                  `json:"tags,omitempty"`
+	Address   address                                    `json:"address"`
+	Billing   *address                                   `json:"billing,omitempty"`
+	Settings  struct{ Theme *wrappers.WrapperString }    `json:"settings"`
+	Labels    map[string]string                          `json:"labels,omitempty"`
+	Avatar    []byte                                     `json:"avatar,omitempty"`
+	Referrer  *customer                                  `json:"referrer,omitempty"`
+	Internal  string                                     `json:"-"`
+	private   string
+}
+
+// code only implements WrapperProvider, i.e. it has no Schema method.
+type code struct {
+	wrappers.Wrapper[string, string]
+}
+
+func (wrapper *code) Wrap(value any, discard bool) error {
+	wrapper.Value, _ = value.(string)
+	return nil
+}
+
+func (wrapper *code) MarshalJSON() ([]byte, error)    { return wrappers.MarshalJSON(wrapper) }
+func (wrapper *code) UnmarshalJSON(data []byte) error { return wrappers.UnmarshalJSON(data, wrapper) }
+func (wrapper *code) UnwrapAny() any                  { return wrapper.Value }
+func (wrapper *code) GetAny() any                     { return wrapper.Value }
+
+func TestGenerate(t *testing.T) {
+	schema, err := Generate(reflect.TypeOf(customer{}))
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	if schema.Dialect != Draft || schema.Type != "object" {
+		t.Errorf("Generate() = %v %v, want an object document", schema.Dialect, schema.Type)
+	}
+
+	want := []string{"created", "id", "email", "birthday", "address", "settings"}
+	if !slices.Equal(schema.Required, want) {
+		t.Errorf("Required = %v, want %v", schema.Required, want)
+	}
+
+	for _, name := range []string{"Internal", "private", "audit"} {
+		if _, ok := schema.Properties[name]; ok {
+			t.Errorf("Properties contain %q", name)
+		}
+	}
+
+	properties := schema.Properties
+
+	if properties["created"].Format != "date-time" || !reflect.DeepEqual(properties["id"].Type, []string{"integer", "null"}) || properties["id"].Maximum != "9223372036854775807" {
+		t.Errorf("created = %+v, id = %+v", properties["created"], properties["id"])
+	}
+
+	if properties["email"].Pattern != regex.WrapperRegexEmailPattern {
+		t.Errorf("email = %+v, want the pattern of the wrapper", properties["email"])
+	}
+
+	if !slices.Equal(properties["direction"].Enum, []any{"north", "east", "south", "west", nil}) {
+		t.Errorf("direction = %+v, want the cardinal directions", properties["direction"])
+	}
+
+	if !reflect.DeepEqual(properties["birthday"].Type, []string{"string", "null"}) || properties["birthday"].Format != "date" {
+		t.Errorf("birthday = %+v, want a nullable date", properties["birthday"])
+	}
+
+	if properties["tags"].Type != "array" || properties["tags"].Items.Type != "string" {
+		t.Errorf("tags = %+v, want an array of strings", properties["tags"])
+	}
+
+	// Named structs are referenced, anonymous structs are inlined.
+	if properties["address"].Ref != "#/$defs/address" || properties["billing"].Ref != "#/$defs/address" {
+		t.Errorf("address = %+v, billing = %+v", properties["address"], properties["billing"])
+	}
+
+	country := schema.Definitions["address"].Properties["country"]
+	if !reflect.DeepEqual(country.Type, []string{"string", "null"}) || !slices.Contains(country.Enum, any("Germany")) {
+		t.Errorf("country = %v %v, want an enum of countries", country.Type, country.Enum)
+	}
+
+	if properties["settings"].Type != "object" || !reflect.DeepEqual(properties["settings"].Properties["Theme"].Type, []string{"string", "null"}) {
+		t.Errorf("settings = %+v, want an inline object", properties["settings"])
+	}
+
+	if properties["labels"].AdditionalProperties.Type != "string" || properties["avatar"].ContentEncoding != "base64" {
+		t.Errorf("labels = %+v, avatar = %+v", properties["labels"], properties["avatar"])
+	}
+
+	// The struct references itself, so it is kept as a definition as well.
+	if properties["referrer"].Ref != "#/$defs/customer" || schema.Definitions["customer"] == nil {
+		t.Errorf("referrer = %+v, want a reference to the document definition", properties["referrer"])
+	}
+
+	if _, err := json.Marshal(schema); err != nil {
+		t.Errorf("json.Marshal() error = %v", err)
+	}
+}
+
+func TestGenerateFields(t *testing.T) {
+	type profile struct {
+		Name     *wrappers.WrapperString `json:"name"`
+		Nickname *wrappers.WrapperString `json:"nickname,omitempty"`
+		Age      wrappers.WrapperInt     `json:"age"`
+		Website  wrappers.WrapperString  `json:"website,omitzero"`
+		Bio      *string                 `json:"bio"`
+	}
+
+	schema, err := Generate(reflect.TypeOf(profile{}))
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	if want := []string{"name", "age", "bio"}; !slices.Equal(schema.Required, want) {
+		t.Errorf("Required = %v, want %v", schema.Required, want)
+	}
+
+	tests := []struct {
+		name string
+		want any
+	}{
+		{name: "name", want: []string{"string", "null"}},
+		{name: "nickname", want: []string{"string", "null"}},
+		{name: "age", want: "integer"},
+		{name: "website", want: "string"},
+		{name: "bio", want: "string"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := schema.Properties[tt.name].Type; !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Type = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateJSON(t *testing.T) {
+	type login struct {
+		Email    *regex.WrapperRegexEmail                   `json:"email" description:"Address the account is registered with."`
+		Remember *wrappers.Discarder[*wrappers.WrapperBool] `json:"remember,omitempty"`
+	}
+
+	schema, err := Generate(reflect.TypeOf(&login{}))
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	got, err := json.Marshal(schema)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	want := `{"$schema":"https://json-schema.org/draft/2020-12/schema","type":"object","properties":{` +
+		`"email":{"description":"Address the account is registered with.","type":["string","null"],"pattern":"^[a-zA-Z0-9._%+\\-]+@[a-zA-Z0-9.\\-]+\\.[a-zA-Z]{2,}$","examples":["alice@example.com"]},` +
+		`"remember":{"type":["boolean","null"],"examples":[true,false]}},"required":["email"]}`
+
+	if string(got) != want {
+		t.Errorf("json.Marshal() = %s, want %s", got, want)
+	}
+}
+
+func TestGenerator(t *testing.T) {
+	t.Run("Shared definitions", func(t *testing.T) {
+		generator := NewGenerator()
+		generator.SetRefPrefix("#/components/schemas/")
+
+		for _, value := range []any{customer{}, address{}} {
+			if _, err := generator.Schema(reflect.TypeOf(value)); err != nil {
+				t.Fatalf("Schema() error = %v", err)
+			}
+		}
+
+		definitions := generator.Definitions()
+		if len(definitions) != 2 || definitions["customer"].Properties["address"].Ref != "#/components/schemas/address" {
+			t.Errorf("Definitions() = %v", definitions)
+		}
+	})
+
+	t.Run("Wrapper without schema", func(t *testing.T) {
+		type document struct {
+			Code *code `json:"code"`
+		}
+
+		schema, err := Generate(reflect.TypeOf(document{}))
+		if err != nil {
+			t.Fatalf("Generate() error = %v", err)
+		}
+
+		if code := schema.Properties["code"]; code.Type != nil || code.Properties != nil {
+			t.Errorf("code = %+v, want a schema accepting any value", code)
+		}
+	})
+
+	t.Run("Unsupported type", func(t *testing.T) {
+		type invalid struct {
+			Events chan string `json:"events"`
+		}
+
+		if _, err := Generate(reflect.TypeOf(invalid{})); err == nil {
+			t.Errorf("Generate() error = nil, want error")
+		}
+	})
+}
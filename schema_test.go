@@ -0,0 +1,137 @@
+package wrappers
+
+import (
+	"encoding/json"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSchema(t *testing.T) {
+	epoch := New[*WrapperTime]()
+	epoch.SetFormat(TimeFormatEpoch)
+
+	iso8601 := New[*WrapperTimeDuration]()
+	iso8601.SetFormat(DurationFormatISO8601)
+
+	tests := []struct {
+		name    string
+		wrapper standardWrapper
+		input   any // If set, the canonical form of the wrapped input has to match the pattern of the schema.
+		want    Schema
+	}{
+		{name: "WrapperBool", wrapper: New[*WrapperBool](), want: Schema{Type: "boolean"}},
+		{name: "WrapperInt", wrapper: New[*WrapperInt](), want: Schema{Type: "integer", Format: "int64", Minimum: "-9223372036854775808", Maximum: "9223372036854775807"}},
+		{name: "WrapperFloat", wrapper: New[*WrapperFloat](), want: Schema{Type: "number", Format: "double"}},
+		{name: "WrapperString", wrapper: New[*WrapperString](), want: Schema{Type: "string"}},
+		{name: "WrapperDate", wrapper: New[*WrapperDate](), want: Schema{Type: "string", Format: "date"}},
+		{name: "WrapperEmail", wrapper: New[*WrapperEmail](), want: Schema{Type: "string", Format: "email"}},
+		{name: "WrapperUrl", wrapper: New[*WrapperUrl](), want: Schema{Type: "string", Format: "uri"}},
+		{name: "WrapperTime", wrapper: New[*WrapperTime](), want: Schema{Type: "string", Format: "date-time"}},
+		{name: "WrapperTime epoch", wrapper: epoch, want: Schema{Type: "integer", Format: "int64"}},
+		{name: "WrapperTimeISO8601", wrapper: New[*WrapperTimeISO8601](), want: Schema{Type: "string", Format: "date-time"}},
+		{name: "WrapperTimeDuration ISO 8601", wrapper: iso8601, want: Schema{Type: "string", Format: "duration"}},
+		{name: "WrapperTimeDuration", wrapper: New[*WrapperTimeDuration](), input: "-1h30m0.5s", want: Schema{Type: "string", Pattern: `^-?([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`}},
+		{name: "WrapperTimeOfDay", wrapper: New[*WrapperTimeOfDay](), input: "17:45:30", want: Schema{Type: "string", Pattern: `^([01][0-9]|2[0-3]):[0-5][0-9](:[0-5][0-9](\.[0-9]{1,9})?)?$`}},
+		{name: "WrapperPhone", wrapper: New[*WrapperPhone](), input: "+49 (30) 123-456", want: Schema{Type: "string", Pattern: `^\+[1-9][0-9]{4,14}$`}},
+		{name: "WrapperTimezone", wrapper: New[*WrapperTimezone](), want: Schema{Type: "string"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.wrapper.Schema()
+			if got.Type != tt.want.Type || got.Format != tt.want.Format || got.Pattern != tt.want.Pattern || got.Minimum != tt.want.Minimum || got.Maximum != tt.want.Maximum || got.Nullable {
+				t.Errorf("Schema() = %+v, want %+v", got, tt.want)
+			}
+
+			if tt.input == nil {
+				return
+			}
+
+			if err := tt.wrapper.Wrap(tt.input, false); err != nil {
+				t.Fatalf("Wrap() error = %v", err)
+			}
+
+			if text := tt.wrapper.String(); !regexp.MustCompile(got.Pattern).MatchString(text) {
+				t.Errorf("canonical form %q does not match pattern %q", text, got.Pattern)
+			}
+		})
+	}
+
+	t.Run("WrapperCountry", func(t *testing.T) {
+		got := New[*WrapperCountry]().Schema()
+		if got.Type != "string" || !slices.Contains(got.Enum, any("Germany")) || slices.Contains(got.Enum, any("Unknown")) {
+			t.Errorf("Schema() = %v %v, want an enum of country names", got.Type, got.Enum)
+		}
+	})
+
+	t.Run("Discarder", func(t *testing.T) {
+		got := (&Discarder[*WrapperDate]{}).Schema()
+		if got.Type != "string" || got.Format != "date" || !got.Nullable {
+			t.Errorf("Schema() = %+v, want a nullable date", got)
+		}
+	})
+}
+
+func TestSchemaExamples(t *testing.T) {
+	tests := []struct {
+		name    string
+		wrapper standardWrapper
+		example string
+	}{
+		{name: "WrapperBool", wrapper: New[*WrapperBool](), example: WrapperBoolExample},
+		{name: "WrapperInt", wrapper: New[*WrapperInt](), example: WrapperIntExample},
+		{name: "WrapperFloat", wrapper: New[*WrapperFloat](), example: WrapperFloatExample},
+		{name: "WrapperString", wrapper: New[*WrapperString](), example: WrapperStringExample},
+		{name: "WrapperCountry", wrapper: New[*WrapperCountry](), example: WrapperCountryExample},
+		{name: "WrapperCron", wrapper: New[*WrapperCron](), example: WrapperCronExample},
+		{name: "WrapperDate", wrapper: New[*WrapperDate](), example: WrapperDateExample},
+		{name: "WrapperEmail", wrapper: New[*WrapperEmail](), example: WrapperEmailExample},
+		{name: "WrapperInterval", wrapper: New[*WrapperInterval](), example: WrapperIntervalExample},
+		{name: "WrapperPhone", wrapper: New[*WrapperPhone](), example: WrapperPhoneExample},
+		{name: "WrapperRecurrence", wrapper: New[*WrapperRecurrence](), example: WrapperRecurrenceExample},
+		{name: "WrapperTime", wrapper: New[*WrapperTime](), example: WrapperTimeExample},
+		{name: "WrapperTimeISO8601", wrapper: New[*WrapperTimeISO8601](), example: WrapperTimeISO8601Example},
+		{name: "WrapperTimeDuration", wrapper: New[*WrapperTimeDuration](), example: WrapperTimeDurationExample},
+		{name: "WrapperTimeOfDay", wrapper: New[*WrapperTimeOfDay](), example: WrapperTimeOfDayExample},
+		{name: "WrapperTimezone", wrapper: New[*WrapperTimezone](), example: WrapperTimezoneExample},
+		{name: "WrapperUrl", wrapper: New[*WrapperUrl](), example: WrapperUrlExample},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			examples := tt.wrapper.Schema().Examples
+			if len(examples) == 0 || len(examples) > len(strings.Split(tt.example, ", ")) {
+				t.Errorf("Schema().Examples = %v, want the valid examples of %q", examples, tt.example)
+			}
+
+			// Discarding the wrapper does not affect its examples.
+			tt.wrapper.Discard()
+			if discarded := tt.wrapper.Schema().Examples; len(discarded) != len(examples) {
+				t.Errorf("Schema().Examples of discarded wrapper = %v, want %v", discarded, examples)
+			}
+		})
+	}
+
+	t.Run("Canonical form", func(t *testing.T) {
+		got, err := json.Marshal(New[*WrapperBool]().Schema().Examples)
+		if err != nil {
+			t.Fatalf("json.Marshal() error = %v", err)
+		}
+
+		if string(got) != "[true,false]" {
+			t.Errorf("Examples = %s, want [true,false]", got)
+		}
+	})
+
+	t.Run("Configuration applies", func(t *testing.T) {
+		wrapper := New[*WrapperTimeDuration]()
+		wrapper.SetFormat(DurationFormatISO8601)
+
+		got, _ := json.Marshal(wrapper.Schema().Examples)
+		if string(got) != `["PT1H30M","P2D"]` {
+			t.Errorf("Examples = %s, want [\"PT1H30M\",\"P2D\"]", got)
+		}
+	})
+}
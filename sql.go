@@ -0,0 +1,118 @@
+package wrappers
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Scan is a generic implementation of the sql.Scanner interface for wrappers. It is used to scan a database value into a wrapper.
+// All wrappers should call this method in their Scan implementation. SQL NULL discards the wrapper without an error,
+// byte slices are wrapped as strings and all other values are passed to Wrap as returned by the driver.
+func Scan(src any, wrapper WrapperProvider) error {
+	if reflect.ValueOf(wrapper).IsNil() {
+		return fmt.Errorf("scan into nil wrapper")
+	}
+
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+
+	switch v := src.(type) {
+	case nil:
+		wrapper.Discard()
+		return nil
+
+	case []byte:
+		return wrap(wrapper, string(v))
+
+	default:
+		return wrap(wrapper, v)
+	}
+}
+
+// DriverValue converts a wrapper into a database/sql/driver value. Discarded wrappers become SQL NULL.
+// Time and date wrappers produce time.Time values, durations are produced as numbers in their configured unit and countries as their ISO 3166-1 alpha-2 code.
+// All other wrappers, including enums and regex wrappers, produce the result of UnwrapAny.
+func DriverValue(wrapper WrapperProvider) (driver.Value, error) {
+	if reflect.ValueOf(wrapper).IsNil() {
+		return nil, fmt.Errorf("value of nil wrapper")
+	}
+
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+
+	if wrapper.IsDiscarded() {
+		return nil, nil
+	}
+
+	switch w := wrapper.(type) {
+	case *WrapperTime:
+		return w.Get(), nil
+
+	case *WrapperTimeISO8601:
+		return w.Get(), nil
+
+	case *WrapperDate:
+		return w.Get().Time(time.UTC), nil
+
+	case *WrapperTimeDuration:
+		unit := w.unit
+		if unit <= 0 {
+			unit = time.Second
+		}
+
+		// Keep whole units as integers and fall back to a fraction so that scanning the value back is lossless.
+		if w.Get()%unit == 0 {
+			return int64(w.Get() / unit), nil
+		}
+		return float64(w.Get()) / float64(unit), nil
+
+	case *WrapperCountry:
+		return w.Get().Alpha2(), nil
+	}
+
+	value := wrapper.UnwrapAny()
+	if !driver.IsValue(value) {
+		return nil, fmt.Errorf("unsupported driver value of type %T", value)
+	}
+
+	return value, nil
+}
+
+// Valuer is a generic type that proxies any WrapperProvider and implements the driver.Valuer interface.
+// Wrappers cannot implement driver.Valuer themselves since their Value field would collide with its Value method.
+// Use it to pass wrappers as query arguments, e.g. db.Exec(query, wrappers.NewValuer(wrapper)).
+type Valuer[W WrapperProvider] struct {
+	Proxy W
+}
+
+// Value returns the driver value of the underlying wrapper. Discarded wrappers become SQL NULL.
+func (valuer Valuer[W]) Value() (driver.Value, error) {
+	return DriverValue(valuer.Proxy)
+}
+
+// Scan scans a database value into the underlying wrapper.
+func (valuer *Valuer[W]) Scan(src any) error {
+	return scan(src, valuer.Proxy)
+}
+
+// NewValuer initializes a new Valuer with the provided underlying wrapper.
+func NewValuer[W WrapperProvider](wrapper W) *Valuer[W] {
+	return &Valuer[W]{
+		Proxy: wrapper,
+	}
+}
+
+// scan scans a database value into the wrapper with its own Scan method (see sql.Scanner) if it has one
+// and falls back to the generic implementation otherwise.
+func scan(src any, wrapper WrapperProvider) error {
+	if scanner, ok := wrapper.(sql.Scanner); ok {
+		return scanner.Scan(src)
+	}
+
+	return Scan(src, wrapper)
+}
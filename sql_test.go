@@ -0,0 +1,461 @@
+package wrappers
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/biter777/countries"
+)
+
+// memoryDriver is a minimal database/sql driver that stores rows in memory. It understands two statements:
+// "INSERT INTO <table>", which appends its arguments as a row, and "SELECT FROM <table>", which returns all rows.
+type memoryDriver struct {
+	mutex     sync.Mutex
+	databases map[string]map[string][][]driver.Value
+}
+
+var memory = &memoryDriver{databases: map[string]map[string][][]driver.Value{}}
+
+func init() {
+	sql.Register("wrappers-memory", memory)
+}
+
+func (memoryDriver *memoryDriver) Open(name string) (driver.Conn, error) {
+	memoryDriver.mutex.Lock()
+	defer memoryDriver.mutex.Unlock()
+
+	if memoryDriver.databases[name] == nil {
+		memoryDriver.databases[name] = map[string][][]driver.Value{}
+	}
+
+	return &memoryConn{driver: memoryDriver, database: name}, nil
+}
+
+type memoryConn struct {
+	driver   *memoryDriver
+	database string
+}
+
+func (conn *memoryConn) Prepare(query string) (driver.Stmt, error) {
+	fields := strings.Fields(query)
+	if len(fields) != 3 || (fields[0] != "INSERT" && fields[0] != "SELECT") {
+		return nil, fmt.Errorf("unsupported query %q", query)
+	}
+
+	return &memoryStmt{conn: conn, command: fields[0], table: fields[2]}, nil
+}
+
+func (conn *memoryConn) Close() error {
+	return nil
+}
+
+func (conn *memoryConn) Begin() (driver.Tx, error) {
+	return nil, fmt.Errorf("transactions are not supported")
+}
+
+type memoryStmt struct {
+	conn    *memoryConn
+	command string
+	table   string
+}
+
+func (stmt *memoryStmt) Close() error {
+	return nil
+}
+
+func (stmt *memoryStmt) NumInput() int {
+	return -1
+}
+
+func (stmt *memoryStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if stmt.command != "INSERT" {
+		return nil, fmt.Errorf("%s is not an exec statement", stmt.command)
+	}
+
+	stmt.conn.driver.mutex.Lock()
+	defer stmt.conn.driver.mutex.Unlock()
+
+	tables := stmt.conn.driver.databases[stmt.conn.database]
+	tables[stmt.table] = append(tables[stmt.table], append([]driver.Value{}, args...))
+
+	return driver.RowsAffected(1), nil
+}
+
+func (stmt *memoryStmt) Query(args []driver.Value) (driver.Rows, error) {
+	if stmt.command != "SELECT" {
+		return nil, fmt.Errorf("%s is not a query statement", stmt.command)
+	}
+
+	stmt.conn.driver.mutex.Lock()
+	defer stmt.conn.driver.mutex.Unlock()
+
+	return &memoryRows{rows: stmt.conn.driver.databases[stmt.conn.database][stmt.table]}, nil
+}
+
+type memoryRows struct {
+	rows  [][]driver.Value
+	index int
+}
+
+func (rows *memoryRows) Columns() []string {
+	if len(rows.rows) == 0 {
+		return nil
+	}
+
+	columns := make([]string, len(rows.rows[0]))
+	for i := range columns {
+		columns[i] = fmt.Sprintf("c%d", i)
+	}
+
+	return columns
+}
+
+func (rows *memoryRows) Close() error {
+	return nil
+}
+
+func (rows *memoryRows) Next(dest []driver.Value) error {
+	if rows.index >= len(rows.rows) {
+		return io.EOF
+	}
+
+	copy(dest, rows.rows[rows.index])
+	rows.index++
+
+	return nil
+}
+
+// openMemoryDatabase opens a fresh in-memory database for the test.
+func openMemoryDatabase(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("wrappers-memory", t.Name())
+	if err != nil {
+		t.Fatalf("sql.Open() error = %v", err)
+	}
+
+	t.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+func TestSQLRoundTrip(t *testing.T) {
+	tests := []struct {
+		name      string
+		wrapper   WrapperProvider
+		value     any
+		scan      WrapperProvider
+		wantValue driver.Value
+	}{
+		{
+			name:      "WrapperBool",
+			wrapper:   New[*WrapperBool](),
+			value:     true,
+			scan:      &WrapperBool{},
+			wantValue: true,
+		},
+		{
+			name:      "WrapperInt",
+			wrapper:   New[*WrapperInt](),
+			value:     int64(42),
+			scan:      &WrapperInt{},
+			wantValue: int64(42),
+		},
+		{
+			name:      "WrapperFloat",
+			wrapper:   New[*WrapperFloat](),
+			value:     3.5,
+			scan:      &WrapperFloat{},
+			wantValue: 3.5,
+		},
+		{
+			name:      "WrapperString",
+			wrapper:   New[*WrapperString](),
+			value:     "hello",
+			scan:      &WrapperString{},
+			wantValue: "hello",
+		},
+		{
+			name:      "WrapperCountry",
+			wrapper:   New[*WrapperCountry](),
+			value:     countries.DE,
+			scan:      &WrapperCountry{},
+			wantValue: "DE",
+		},
+		{
+			name:      "WrapperTime",
+			wrapper:   New[*WrapperTime](),
+			value:     time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC),
+			scan:      &WrapperTime{},
+			wantValue: time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC),
+		},
+		{
+			name:      "WrapperTimeISO8601",
+			wrapper:   New[*WrapperTimeISO8601](),
+			value:     "2024-03-01T12:30:00Z",
+			scan:      &WrapperTimeISO8601{},
+			wantValue: time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC),
+		},
+		{
+			name:      "WrapperDate",
+			wrapper:   New[*WrapperDate](),
+			value:     "2024-03-01",
+			scan:      &WrapperDate{},
+			wantValue: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "WrapperTimeDuration",
+			wrapper:   New[*WrapperTimeDuration](),
+			value:     "1h30m",
+			scan:      &WrapperTimeDuration{},
+			wantValue: int64(5400),
+		},
+		{
+			name:      "WrapperTimeDuration fraction",
+			wrapper:   New[*WrapperTimeDuration](),
+			value:     "1.5s",
+			scan:      &WrapperTimeDuration{},
+			wantValue: 1.5,
+		},
+		{
+			name:      "WrapperTimeOfDay",
+			wrapper:   New[*WrapperTimeOfDay](),
+			value:     "09:30",
+			scan:      &WrapperTimeOfDay{},
+			wantValue: "09:30",
+		},
+		{
+			name:      "WrapperTimezone",
+			wrapper:   New[*WrapperTimezone](),
+			value:     "Europe/Berlin",
+			scan:      &WrapperTimezone{},
+			wantValue: "Europe/Berlin",
+		},
+		{
+			name:      "WrapperEmail",
+			wrapper:   New[*WrapperEmail](),
+			value:     "jane@example.com",
+			scan:      &WrapperEmail{},
+			wantValue: "jane@example.com",
+		},
+		{
+			name:      "WrapperUrl",
+			wrapper:   New[*WrapperUrl](),
+			value:     "https://example.com/path",
+			scan:      &WrapperUrl{},
+			wantValue: "https://example.com/path",
+		},
+		{
+			name:      "WrapperCron",
+			wrapper:   New[*WrapperCron](),
+			value:     "*/15 * * * *",
+			scan:      &WrapperCron{},
+			wantValue: "*/15 * * * *",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := openMemoryDatabase(t)
+
+			if err := tt.wrapper.Wrap(tt.value, false); err != nil {
+				t.Fatalf("Wrap() error = %v", err)
+			}
+
+			value, err := DriverValue(tt.wrapper)
+			if err != nil {
+				t.Fatalf("DriverValue() error = %v", err)
+			}
+
+			if wantTime, ok := tt.wantValue.(time.Time); ok {
+				if gotTime, ok := value.(time.Time); !ok || !gotTime.Equal(wantTime) {
+					t.Errorf("DriverValue() = %v, want %v", value, tt.wantValue)
+				}
+			} else if value != tt.wantValue {
+				t.Errorf("DriverValue() = %#v, want %#v", value, tt.wantValue)
+			}
+
+			if _, err := db.Exec("INSERT INTO values", NewValuer(tt.wrapper)); err != nil {
+				t.Fatalf("Exec() error = %v", err)
+			}
+
+			if err := db.QueryRow("SELECT FROM values").Scan(tt.scan); err != nil {
+				t.Fatalf("Scan() error = %v", err)
+			}
+
+			if tt.scan.IsDiscarded() {
+				t.Fatalf("IsDiscarded() = true, want false")
+			}
+
+			if tt.scan.UnwrapAny() != tt.wrapper.UnwrapAny() {
+				t.Errorf("UnwrapAny() = %v, want %v", tt.scan.UnwrapAny(), tt.wrapper.UnwrapAny())
+			}
+		})
+	}
+}
+
+func TestSQLNull(t *testing.T) {
+	db := openMemoryDatabase(t)
+
+	discarded := New[*WrapperString]()
+	discarded.Discard()
+
+	if _, err := db.Exec("INSERT INTO values", NewValuer(discarded)); err != nil {
+		t.Fatalf("Exec() error = %v", err)
+	}
+
+	var raw any
+	if err := db.QueryRow("SELECT FROM values").Scan(&raw); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+
+	if raw != nil {
+		t.Errorf("stored value = %v, want NULL", raw)
+	}
+
+	scanned := &WrapperString{}
+	if err := db.QueryRow("SELECT FROM values").Scan(scanned); err != nil {
+		t.Fatalf("Scan() error = %v, want nil", err)
+	}
+
+	if !scanned.IsDiscarded() {
+		t.Errorf("IsDiscarded() = false, want true")
+	}
+}
+
+func TestSQLScanInvalid(t *testing.T) {
+	db := openMemoryDatabase(t)
+
+	if _, err := db.Exec("INSERT INTO values", "not a number"); err != nil {
+		t.Fatalf("Exec() error = %v", err)
+	}
+
+	scanned := &WrapperInt{}
+	if err := db.QueryRow("SELECT FROM values").Scan(scanned); err == nil {
+		t.Errorf("Scan() error = nil, want error")
+	}
+
+	// The Discarder suppresses the error and discards the value instead.
+	discarder := NewDiscarder(&WrapperInt{})
+	if err := db.QueryRow("SELECT FROM values").Scan(discarder); err != nil {
+		t.Fatalf("Scan() error = %v, want nil", err)
+	}
+
+	if !discarder.Proxy.IsDiscarded() {
+		t.Errorf("IsDiscarded() = false, want true")
+	}
+}
+
+func TestSQLDiscarderValue(t *testing.T) {
+	db := openMemoryDatabase(t)
+
+	wrapper, err := NewWithValue[*WrapperInt](int64(7))
+	if err != nil {
+		t.Fatalf("NewWithValue() error = %v", err)
+	}
+
+	if _, err := db.Exec("INSERT INTO values", NewDiscarder(wrapper)); err != nil {
+		t.Fatalf("Exec() error = %v", err)
+	}
+
+	scanned := NewDiscarder(&WrapperInt{})
+	if err := db.QueryRow("SELECT FROM values").Scan(scanned); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+
+	if scanned.Proxy.Get() != 7 {
+		t.Errorf("Get() = %v, want 7", scanned.Proxy.Get())
+	}
+}
+
+func TestScan(t *testing.T) {
+	tests := []struct {
+		name      string
+		wrapper   WrapperProvider
+		src       any
+		want      any
+		wantError bool
+	}{
+		{
+			name:    "Bytes as string",
+			wrapper: &WrapperInt{},
+			src:     []byte("42"),
+			want:    int64(42),
+		},
+		{
+			name:    "Integer as bool",
+			wrapper: &WrapperBool{},
+			src:     int64(1),
+			want:    true,
+		},
+		{
+			name:    "Numeric bytes as float",
+			wrapper: &WrapperFloat{},
+			src:     []byte("12.50"),
+			want:    12.5,
+		},
+		{
+			name:    "Country code",
+			wrapper: &WrapperCountry{},
+			src:     "DE",
+			want:    "Germany",
+		},
+		{
+			name:    "Time as date",
+			wrapper: &WrapperDate{},
+			src:     time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			want:    "2024-03-01",
+		},
+		{
+			name:      "Invalid date",
+			wrapper:   &WrapperDate{},
+			src:       "2024-02-30",
+			wantError: true,
+		},
+		{
+			name:      "Unsupported type",
+			wrapper:   &WrapperString{},
+			src:       time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Scan(tt.src, tt.wrapper)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("Scan() error = %v, wantError %v", err, tt.wantError)
+			}
+
+			if tt.wantError {
+				return
+			}
+
+			if !tt.wrapper.IsInitialized() {
+				t.Errorf("IsInitialized() = false, want true")
+			}
+
+			if tt.wrapper.UnwrapAny() != tt.want {
+				t.Errorf("UnwrapAny() = %v, want %v", tt.wrapper.UnwrapAny(), tt.want)
+			}
+		})
+	}
+}
+
+func TestDriverValueNil(t *testing.T) {
+	var wrapper *WrapperInt
+
+	if _, err := DriverValue(wrapper); err == nil {
+		t.Errorf("DriverValue() error = nil, want error")
+	}
+
+	if err := Scan(int64(1), wrapper); err == nil {
+		t.Errorf("Scan() error = nil, want error")
+	}
+}
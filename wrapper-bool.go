@@ -1,6 +1,7 @@
 package wrappers
 
 import (
+	"encoding/xml"
 	"fmt"
 	"strings"
 )
@@ -107,3 +108,68 @@ func (wrapper *WrapperBool) UnmarshalJSON(data []byte) error {
 
 	return UnmarshalJSON(data, wrapper)
 }
+
+func (wrapper *WrapperBool) MarshalText() ([]byte, error) {
+	return MarshalText(wrapper)
+}
+
+func (wrapper *WrapperBool) UnmarshalText(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalText(data, wrapper)
+}
+
+func (wrapper *WrapperBool) Scan(src any) error {
+	if wrapper == nil {
+		return fmt.Errorf("scan into nil wrapper")
+	}
+
+	return Scan(src, wrapper)
+}
+
+func (wrapper *WrapperBool) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return MarshalXML(encoder, start, wrapper)
+}
+
+func (wrapper *WrapperBool) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXML(decoder, start, wrapper)
+}
+
+func (wrapper *WrapperBool) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return MarshalXMLAttr(name, wrapper)
+}
+
+func (wrapper *WrapperBool) UnmarshalXMLAttr(attr xml.Attr) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXMLAttr(attr, wrapper)
+}
+
+func (wrapper *WrapperBool) Set(value string) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return Set(value, wrapper)
+}
+
+func (wrapper *WrapperBool) String() string {
+	return String(wrapper)
+}
+
+func (wrapper *WrapperBool) Schema() Schema {
+	return Schema{Type: "boolean", Examples: SchemaExamples(WrapperBoolExample, wrapper)}
+}
+
+// IsBoolFlag allows the wrapper to be used as a command-line flag without a value, e.g. "-verbose" instead of "-verbose=true".
+func (wrapper *WrapperBool) IsBoolFlag() bool {
+	return true
+}
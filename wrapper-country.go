@@ -1,13 +1,15 @@
 package wrappers
 
 import (
+	"encoding/xml"
 	"fmt"
 
 	"github.com/biter777/countries"
 )
 
 const (
-	WrapperCountryName Name = "WrapperCountry"
+	WrapperCountryName    Name   = "WrapperCountry"
+	WrapperCountryExample string = "DE, France"
 )
 
 type WrapperCountry Wrapper[countries.CountryCode, string]
@@ -106,3 +108,71 @@ func (wrapper *WrapperCountry) UnmarshalJSON(data []byte) error {
 
 	return UnmarshalJSON(data, wrapper)
 }
+
+func (wrapper *WrapperCountry) MarshalText() ([]byte, error) {
+	return MarshalText(wrapper)
+}
+
+func (wrapper *WrapperCountry) UnmarshalText(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalText(data, wrapper)
+}
+
+func (wrapper *WrapperCountry) Scan(src any) error {
+	if wrapper == nil {
+		return fmt.Errorf("scan into nil wrapper")
+	}
+
+	return Scan(src, wrapper)
+}
+
+func (wrapper *WrapperCountry) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return MarshalXML(encoder, start, wrapper)
+}
+
+func (wrapper *WrapperCountry) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXML(decoder, start, wrapper)
+}
+
+func (wrapper *WrapperCountry) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return MarshalXMLAttr(name, wrapper)
+}
+
+func (wrapper *WrapperCountry) UnmarshalXMLAttr(attr xml.Attr) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXMLAttr(attr, wrapper)
+}
+
+func (wrapper *WrapperCountry) Set(value string) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return Set(value, wrapper)
+}
+
+func (wrapper *WrapperCountry) String() string {
+	return String(wrapper)
+}
+
+// Schema describes the English country names wrappers marshal to. Unmarshalling accepts ISO 3166-1 codes as well.
+func (wrapper *WrapperCountry) Schema() Schema {
+	codes := countries.All()
+
+	names := make([]any, len(codes))
+	for i, code := range codes {
+		names[i] = code.String()
+	}
+
+	return Schema{Type: "string", Enum: names, Examples: SchemaExamples(WrapperCountryExample, wrapper)}
+}
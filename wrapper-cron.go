@@ -0,0 +1,467 @@
+package wrappers
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	WrapperCronName    Name   = "WrapperCron"
+	WrapperCronExample string = "*/15 9-17 * * MON-FRI, 0 30 2 1 * *, @daily"
+)
+
+// CronSeconds defines whether cron expressions may or have to include a leading seconds field.
+type CronSeconds int
+
+const (
+	CronSecondsOptional CronSeconds = iota // Both 5-field and 6-field expressions are accepted.
+	CronSecondsNone                        // Only standard 5-field expressions are accepted.
+	CronSecondsRequired                    // Only 6-field expressions with a leading seconds field are accepted.
+)
+
+// cronField describes the bounds and names of a single cron field.
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	cronFieldSecond  = cronField{name: "second", min: 0, max: 59}
+	cronFieldMinute  = cronField{name: "minute", min: 0, max: 59}
+	cronFieldHour    = cronField{name: "hour", min: 0, max: 23}
+	cronFieldDay     = cronField{name: "day of month", min: 1, max: 31}
+	cronFieldMonth   = cronField{name: "month", min: 1, max: 12, names: map[string]int{"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6, "JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12}}
+	cronFieldWeekday = cronField{name: "day of week", min: 0, max: 7, names: map[string]int{"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6}}
+)
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 0 1 1 *",
+	"@annually": "0 0 0 1 1 *",
+	"@monthly":  "0 0 0 1 * *",
+	"@weekly":   "0 0 0 * * 0",
+	"@daily":    "0 0 0 * * *",
+	"@midnight": "0 0 0 * * *",
+	"@hourly":   "0 0 * * * *",
+}
+
+// cronSearchYears bounds the search for the next activation of a schedule.
+const cronSearchYears = 5
+
+// CronSchedule is a parsed cron expression. Each field is stored as a bit set of the values it matches.
+type CronSchedule struct {
+	expression string
+	seconds    uint64
+	minutes    uint64
+	hours      uint64
+	days       uint64
+	months     uint64
+	weekdays   uint64
+	dayAny     bool // The day of month field is "*" or "?".
+	weekdayAny bool // The day of week field is "*" or "?".
+}
+
+// ParseCron parses a cron expression with 5 fields (minute, hour, day of month, month, day of week), 6 fields with a leading seconds field, or a macro such as "@daily".
+// Fields support lists, ranges, steps and month and weekday names. Day of week 7 is Sunday.
+// As in Vixie cron, a schedule restricting both the day of month and the day of week fires when either matches.
+func ParseCron(expression string) (CronSchedule, error) {
+	fields := strings.Fields(expression)
+	if len(fields) == 1 && strings.HasPrefix(fields[0], "@") {
+		macro, ok := cronMacros[strings.ToLower(fields[0])]
+		if !ok {
+			return CronSchedule{}, fmt.Errorf("unknown cron macro %q", fields[0])
+		}
+
+		fields = strings.Fields(macro)
+	}
+
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return CronSchedule{}, fmt.Errorf("cron expression with 5 or 6 fields, got %d", len(fields))
+	}
+
+	schedule := CronSchedule{expression: strings.Join(strings.Fields(expression), " ")}
+
+	targets := []*uint64{&schedule.seconds, &schedule.minutes, &schedule.hours, &schedule.days, &schedule.months, &schedule.weekdays}
+	definitions := []cronField{cronFieldSecond, cronFieldMinute, cronFieldHour, cronFieldDay, cronFieldMonth, cronFieldWeekday}
+
+	for i, field := range fields {
+		bits, err := parseCronField(field, definitions[i])
+		if err != nil {
+			return CronSchedule{}, err
+		}
+
+		*targets[i] = bits
+	}
+
+	// Sunday may be written as 0 or 7.
+	if schedule.weekdays&(1<<7) != 0 {
+		schedule.weekdays = schedule.weekdays&^(1<<7) | 1
+	}
+
+	schedule.dayAny = strings.HasPrefix(fields[3], "*") || fields[3] == "?"
+	schedule.weekdayAny = strings.HasPrefix(fields[5], "*") || fields[5] == "?"
+
+	if schedule.weekdayAny && !schedule.fires() {
+		return CronSchedule{}, fmt.Errorf("cron expression with a day of month that exists in its months")
+	}
+
+	return schedule, nil
+}
+
+// parseCronField parses a comma separated list of values, ranges and steps into a bit set.
+func parseCronField(value string, field cronField) (uint64, error) {
+	if value == "?" {
+		if field.name != cronFieldDay.name && field.name != cronFieldWeekday.name {
+			return 0, fmt.Errorf("cron %s may not be \"?\"", field.name)
+		}
+
+		value = "*"
+	}
+
+	var bits uint64
+	for _, part := range strings.Split(value, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepPart); err != nil || step < 1 {
+				return 0, fmt.Errorf("cron %s step of at least 1, got %q", field.name, stepPart)
+			}
+		}
+
+		var start, end int
+		switch {
+		case rangePart == "*":
+			start, end = field.min, field.max
+
+		case strings.Contains(rangePart, "-"):
+			low, high, _ := strings.Cut(rangePart, "-")
+
+			var err error
+			if start, err = parseCronValue(low, field); err != nil {
+				return 0, err
+			}
+			if end, err = parseCronValue(high, field); err != nil {
+				return 0, err
+			}
+
+			if start > end {
+				return 0, fmt.Errorf("cron %s range with start before end, got %q", field.name, rangePart)
+			}
+
+		default:
+			var err error
+			if start, err = parseCronValue(rangePart, field); err != nil {
+				return 0, err
+			}
+
+			// A single value with a step runs to the end of the field, e.g. "5/15".
+			end = start
+			if hasStep {
+				end = field.max
+			}
+		}
+
+		for i := start; i <= end; i += step {
+			bits |= 1 << i
+		}
+	}
+
+	return bits, nil
+}
+
+func parseCronValue(value string, field cronField) (int, error) {
+	if number, ok := field.names[strings.ToUpper(value)]; ok {
+		return number, nil
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil || number < field.min || number > field.max {
+		return 0, fmt.Errorf("cron %s between %d and %d, got %q", field.name, field.min, field.max, value)
+	}
+
+	return number, nil
+}
+
+// fires checks if any selected day of month exists in any selected month, counting February 29th.
+func (schedule CronSchedule) fires() bool {
+	lengths := [13]int{0, 31, 29, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+
+	for month := 1; month <= 12; month++ {
+		if schedule.months&(1<<month) == 0 {
+			continue
+		}
+
+		for day := 1; day <= lengths[month]; day++ {
+			if schedule.days&(1<<day) != 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// matchesDay checks the day of month and day of week fields for the date of a time.
+func (schedule CronSchedule) matchesDay(value time.Time) bool {
+	day := schedule.days&(1<<value.Day()) != 0
+	weekday := schedule.weekdays&(1<<int(value.Weekday())) != 0
+
+	if schedule.dayAny || schedule.weekdayAny {
+		return day && weekday
+	}
+
+	return day || weekday
+}
+
+// Next returns the first activation strictly after the given time in its location. The zero time is returned if there is none within five years.
+// Local times skipped by daylight saving transitions are not activated.
+func (schedule CronSchedule) Next(after time.Time) time.Time {
+	if schedule.months == 0 {
+		return time.Time{}
+	}
+
+	location := after.Location()
+	value := after.Add(time.Second - time.Duration(after.Nanosecond()))
+	limit := value.Year() + cronSearchYears
+
+restart:
+	if value.Year() > limit {
+		return time.Time{}
+	}
+
+	for schedule.months&(1<<int(value.Month())) == 0 {
+		value = time.Date(value.Year(), value.Month()+1, 1, 0, 0, 0, 0, location)
+		if value.Month() == time.January {
+			goto restart
+		}
+	}
+
+	for !schedule.matchesDay(value) {
+		value = time.Date(value.Year(), value.Month(), value.Day()+1, 0, 0, 0, 0, location)
+		if value.Day() == 1 {
+			goto restart
+		}
+	}
+
+	for schedule.hours&(1<<value.Hour()) == 0 {
+		value = time.Date(value.Year(), value.Month(), value.Day(), value.Hour()+1, 0, 0, 0, location)
+		if value.Hour() == 0 {
+			goto restart
+		}
+	}
+
+	for schedule.minutes&(1<<value.Minute()) == 0 {
+		value = value.Add(time.Minute - time.Duration(value.Second())*time.Second)
+		if value.Minute() == 0 {
+			goto restart
+		}
+	}
+
+	for schedule.seconds&(1<<value.Second()) == 0 {
+		value = value.Add(time.Second)
+		if value.Second() == 0 {
+			goto restart
+		}
+	}
+
+	return value
+}
+
+// String returns the expression the schedule was parsed from with normalized whitespace.
+func (schedule CronSchedule) String() string {
+	return schedule.expression
+}
+
+// WrapperCron wraps a cron expression. Standard 5-field and 6-field expressions with leading seconds as well as macros like "@hourly" are accepted.
+type WrapperCron struct {
+	Wrapper[CronSchedule, string]
+	seconds CronSeconds // Whether a seconds field is allowed or required. Defaults to CronSecondsOptional.
+}
+
+var _ WrapperProvider = (*WrapperCron)(nil) // Ensure that WrapperCron implements WrapperProvider.
+
+// SetSeconds configures whether expressions may or have to include a leading seconds field. Macros are always accepted.
+func (wrapper *WrapperCron) SetSeconds(seconds CronSeconds) {
+	wrapper.seconds = seconds
+}
+
+func (wrapper *WrapperCron) Get() CronSchedule {
+	return wrapper.Value
+}
+
+func (wrapper *WrapperCron) GetAny() any {
+	return wrapper.Get()
+}
+
+// Next returns the first activation strictly after the given time. The zero time is returned for discarded wrappers.
+func (wrapper *WrapperCron) Next(after time.Time) time.Time {
+	if wrapper.IsDiscarded() {
+		return time.Time{}
+	}
+
+	return wrapper.Value.Next(after)
+}
+
+func (wrapper *WrapperCron) Wrap(value any, discard bool) error {
+	switch v := value.(type) {
+	case nil:
+		wrapper.Discard()
+		if !discard {
+			return ErrorNil(WrapperCronName)
+		}
+
+	case WrapperProvider:
+		if v.IsDiscarded() {
+			wrapper.Discard()
+			return nil
+		}
+
+		return wrapper.Wrap(v.UnwrapAny(), discard)
+
+	case CronSchedule:
+		return wrapper.Wrap(v.String(), discard)
+
+	case string:
+		fields := strings.Fields(v)
+		if len(fields) == 0 {
+			wrapper.Discard()
+			if !discard {
+				return ErrorNil(WrapperCronName)
+			}
+			return nil
+		}
+
+		if (wrapper.seconds == CronSecondsNone && len(fields) == 6) || (wrapper.seconds == CronSecondsRequired && len(fields) == 5) {
+			wrapper.Discard()
+			if !discard {
+				return ErrorValue(WrapperCronName, value, wrapper.expected())
+			}
+			return nil
+		}
+
+		schedule, err := ParseCron(v)
+		if err != nil {
+			wrapper.Discard()
+			if !discard {
+				return ErrorParse(WrapperCronName, value, err)
+			}
+			return nil
+		}
+
+		wrapper.Value = schedule
+
+	default:
+		wrapper.Discard()
+		if !discard {
+			return ErrorType(WrapperCronName, value)
+		}
+	}
+
+	return nil
+}
+
+// expected describes the accepted field counts for error messages.
+func (wrapper *WrapperCron) expected() string {
+	switch wrapper.seconds {
+	case CronSecondsNone:
+		return "cron expression with 5 fields"
+	case CronSecondsRequired:
+		return "cron expression with 6 fields"
+	default:
+		return "cron expression with 5 or 6 fields"
+	}
+}
+
+func (wrapper *WrapperCron) Unwrap() string {
+	if wrapper.IsDiscarded() {
+		return ""
+	}
+
+	return wrapper.Value.String()
+}
+
+func (wrapper *WrapperCron) UnwrapAny() any {
+	return wrapper.Unwrap()
+}
+
+func (wrapper *WrapperCron) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(wrapper)
+}
+
+func (wrapper *WrapperCron) UnmarshalJSON(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalJSON(data, wrapper)
+}
+
+func (wrapper *WrapperCron) MarshalText() ([]byte, error) {
+	return MarshalText(wrapper)
+}
+
+func (wrapper *WrapperCron) UnmarshalText(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalText(data, wrapper)
+}
+
+func (wrapper *WrapperCron) Scan(src any) error {
+	if wrapper == nil {
+		return fmt.Errorf("scan into nil wrapper")
+	}
+
+	return Scan(src, wrapper)
+}
+
+func (wrapper *WrapperCron) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return MarshalXML(encoder, start, wrapper)
+}
+
+func (wrapper *WrapperCron) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXML(decoder, start, wrapper)
+}
+
+func (wrapper *WrapperCron) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return MarshalXMLAttr(name, wrapper)
+}
+
+func (wrapper *WrapperCron) UnmarshalXMLAttr(attr xml.Attr) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXMLAttr(attr, wrapper)
+}
+
+func (wrapper *WrapperCron) Set(value string) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return Set(value, wrapper)
+}
+
+func (wrapper *WrapperCron) String() string {
+	return String(wrapper)
+}
+
+func (wrapper *WrapperCron) Schema() Schema {
+	return Schema{Type: "string", Examples: SchemaExamples(WrapperCronExample, wrapper)}
+}
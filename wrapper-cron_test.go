@@ -0,0 +1,137 @@
+package wrappers
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// TestWrapperCron_Wrap tests the Wrap method of WrapperCron.
+func TestWrapperCron_Wrap(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       any
+		seconds     CronSeconds
+		discard     bool
+		want        string
+		wantError   bool
+		wantDiscard bool
+	}{
+		{name: "Wrap 5 fields", input: "*/15 9-17 * * MON-FRI", want: "*/15 9-17 * * MON-FRI"},
+		{name: "Wrap 6 fields", input: "0 30 2 1 * *", want: "0 30 2 1 * *"},
+		{name: "Wrap normalizes whitespace", input: " 0  12 * * * ", want: "0 12 * * *"},
+		{name: "Wrap lists and names", input: "0 0 1,15 jan,jul ?", want: "0 0 1,15 jan,jul ?"},
+		{name: "Wrap Sunday as 7", input: "0 0 * * 7", want: "0 0 * * 7"},
+		{name: "Wrap macro", input: "@daily", want: "@daily"},
+		{name: "Wrap macro uppercase", input: "@HOURLY", want: "@HOURLY"},
+		{name: "Wrap unknown macro", input: "@reboot", wantError: true, wantDiscard: true},
+		{name: "Wrap too few fields", input: "* * * *", wantError: true, wantDiscard: true},
+		{name: "Wrap too many fields", input: "* * * * * * *", wantError: true, wantDiscard: true},
+		{name: "Wrap minute out of range", input: "60 * * * *", wantError: true, wantDiscard: true},
+		{name: "Wrap hour out of range", input: "0 24 * * *", wantError: true, wantDiscard: true},
+		{name: "Wrap day of month zero", input: "0 0 0 * *", wantError: true, wantDiscard: true},
+		{name: "Wrap reversed range", input: "0 17-9 * * *", wantError: true, wantDiscard: true},
+		{name: "Wrap zero step", input: "*/0 * * * *", wantError: true, wantDiscard: true},
+		{name: "Wrap question mark in minute", input: "? * * * *", wantError: true, wantDiscard: true},
+		{name: "Wrap impossible date", input: "0 0 30 2 *", wantError: true, wantDiscard: true},
+		{name: "Wrap impossible date with discard", input: "0 0 30 2 *", discard: true, wantDiscard: true},
+		{name: "Wrap leap day", input: "0 0 29 2 *", want: "0 0 29 2 *"},
+		{name: "Wrap seconds rejected", input: "0 0 0 * * *", seconds: CronSecondsNone, wantError: true, wantDiscard: true},
+		{name: "Wrap seconds required", input: "0 0 * * *", seconds: CronSecondsRequired, wantError: true, wantDiscard: true},
+		{name: "Wrap macro with seconds required", input: "@weekly", seconds: CronSecondsRequired, want: "@weekly"},
+		{name: "Wrap empty", input: "", wantError: true, wantDiscard: true},
+		{name: "Wrap unsupported type", input: 5, wantError: true, wantDiscard: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapper := New[*WrapperCron]()
+			wrapper.SetSeconds(tt.seconds)
+
+			err := wrapper.Wrap(tt.input, tt.discard)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("Wrap() error = %v, wantError %v", err, tt.wantError)
+			}
+
+			if wrapper.IsDiscarded() != tt.wantDiscard {
+				t.Errorf("IsDiscarded() = %v, want %v", wrapper.IsDiscarded(), tt.wantDiscard)
+			}
+
+			if !tt.wantDiscard && wrapper.Unwrap() != tt.want {
+				t.Errorf("Unwrap() = %v, want %v", wrapper.Unwrap(), tt.want)
+			}
+		})
+	}
+}
+
+// TestWrapperCron_Next tests the next activation calculation of WrapperCron.
+func TestWrapperCron_Next(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Monday, January 1st 2024.
+	after := time.Date(2024, time.January, 1, 10, 7, 30, 500, time.UTC)
+
+	tests := []struct {
+		name       string
+		expression string
+		after      time.Time
+		want       time.Time
+	}{
+		{name: "Every minute", expression: "* * * * *", after: after, want: time.Date(2024, time.January, 1, 10, 8, 0, 0, time.UTC)},
+		{name: "Every second", expression: "* * * * * *", after: after, want: time.Date(2024, time.January, 1, 10, 7, 31, 0, time.UTC)},
+		{name: "Quarter hours", expression: "*/15 * * * *", after: after, want: time.Date(2024, time.January, 1, 10, 15, 0, 0, time.UTC)},
+		{name: "Strictly after", expression: "0 12 * * *", after: time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC), want: time.Date(2024, time.January, 2, 12, 0, 0, 0, time.UTC)},
+		{name: "Daily macro", expression: "@daily", after: after, want: time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC)},
+		{name: "Hourly macro", expression: "@hourly", after: after, want: time.Date(2024, time.January, 1, 11, 0, 0, 0, time.UTC)},
+		{name: "Weekdays", expression: "0 9 * * MON-FRI", after: time.Date(2024, time.January, 5, 10, 0, 0, 0, time.UTC), want: time.Date(2024, time.January, 8, 9, 0, 0, 0, time.UTC)},
+		{name: "Sunday as 7", expression: "0 0 * * 7", after: after, want: time.Date(2024, time.January, 7, 0, 0, 0, 0, time.UTC)},
+		{name: "Month rollover", expression: "0 0 1 * *", after: after, want: time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "Year rollover", expression: "0 0 1 1 *", after: after, want: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "Leap day", expression: "0 0 29 2 *", after: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC), want: time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{name: "Day of month or week", expression: "0 0 15 * FRI", after: after, want: time.Date(2024, time.January, 5, 0, 0, 0, 0, time.UTC)},
+		{name: "Stepped day of month and week", expression: "0 0 */10 * FRI", after: after, want: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "Location", expression: "0 9 * * *", after: time.Date(2024, time.January, 1, 10, 0, 0, 0, berlin), want: time.Date(2024, time.January, 2, 9, 0, 0, 0, berlin)},
+		{name: "Skipped by daylight saving", expression: "30 2 * * *", after: time.Date(2024, time.March, 30, 12, 0, 0, 0, berlin), want: time.Date(2024, time.April, 1, 2, 30, 0, 0, berlin)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapper := New[*WrapperCron]()
+			if err := wrapper.Wrap(tt.expression, false); err != nil {
+				t.Fatalf("Wrap() error = %v", err)
+			}
+
+			if got := wrapper.Next(tt.after); !got.Equal(tt.want) {
+				t.Errorf("Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestWrapperCron_JSON tests JSON marshalling and unmarshalling of WrapperCron within a struct.
+func TestWrapperCron_JSON(t *testing.T) {
+	type Job struct {
+		Schedule *WrapperCron `json:"schedule"`
+	}
+
+	var job Job
+	if err := json.Unmarshal([]byte(`{"schedule":"0 3 * * SUN"}`), &job); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	data, err := json.Marshal(job)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	if string(data) != `{"schedule":"0 3 * * SUN"}` {
+		t.Errorf("Marshalled JSON = %v", string(data))
+	}
+
+	if err := json.Unmarshal([]byte(`{"schedule":"0 3 * * SUNDAY"}`), &job); err == nil {
+		t.Errorf("Unmarshal() expected error for invalid weekday")
+	}
+}
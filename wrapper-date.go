@@ -0,0 +1,277 @@
+package wrappers
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	WrapperDateName    Name   = "WrapperDate"
+	WrapperDateExample string = "2024-02-29"
+	WrapperDateLayout  string = time.DateOnly // The canonical layout dates are unwrapped in.
+)
+
+// Date is a calendar date without a time of day or location.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// DateOf returns the date of a time in its own location.
+func DateOf(value time.Time) Date {
+	year, month, day := value.Date()
+	return Date{Year: year, Month: month, Day: day}
+}
+
+// IsValid checks if the date exists in the proleptic Gregorian calendar.
+func (date Date) IsValid() bool {
+	return DateOf(date.Time(time.UTC)) == date
+}
+
+// Time returns the start of the date in the given location.
+func (date Date) Time(location *time.Location) time.Time {
+	return time.Date(date.Year, date.Month, date.Day, 0, 0, 0, 0, location)
+}
+
+// AddDays returns the date the given number of days later.
+func (date Date) AddDays(days int) Date {
+	return DateOf(date.Time(time.UTC).AddDate(0, 0, days))
+}
+
+// Compare returns -1 if the date is before the other date, 1 if it is after and 0 if they are equal.
+func (date Date) Compare(other Date) int {
+	switch {
+	case date.Year != other.Year:
+		return compareInt(date.Year, other.Year)
+	case date.Month != other.Month:
+		return compareInt(int(date.Month), int(other.Month))
+	default:
+		return compareInt(date.Day, other.Day)
+	}
+}
+
+func (date Date) Before(other Date) bool {
+	return date.Compare(other) < 0
+}
+
+func (date Date) After(other Date) bool {
+	return date.Compare(other) > 0
+}
+
+// String returns the date in its canonical form "2006-01-02".
+func (date Date) String() string {
+	return date.Time(time.UTC).Format(WrapperDateLayout)
+}
+
+// WrapperDate wraps a calendar date. By default only "2006-01-02" strings are accepted.
+type WrapperDate struct {
+	Wrapper[Date, string]
+	TimeConstraints
+	layouts []string // Layouts tried in order when parsing strings. Defaults to WrapperDateLayout.
+}
+
+var _ WrapperProvider = (*WrapperDate)(nil) // Ensure that WrapperDate implements WrapperProvider.
+
+// SetLayouts sets the layouts (see time.Parse) that are tried in order when parsing strings. Dates are always unwrapped in the canonical layout.
+func (wrapper *WrapperDate) SetLayouts(layouts []string) {
+	wrapper.layouts = layouts
+}
+
+func (wrapper *WrapperDate) Get() Date {
+	return wrapper.Value
+}
+
+func (wrapper *WrapperDate) GetAny() any {
+	return wrapper.Get()
+}
+
+func (wrapper *WrapperDate) Wrap(value any, discard bool) error {
+	switch v := value.(type) {
+	case nil:
+		wrapper.Discard()
+		if !discard {
+			return ErrorNil(WrapperDateName)
+		}
+
+	case WrapperProvider:
+		if v.IsDiscarded() {
+			wrapper.Discard()
+			return nil
+		}
+
+		return wrapper.Wrap(v.UnwrapAny(), discard)
+
+	case Date:
+		if !v.IsValid() {
+			wrapper.Discard()
+			if !discard {
+				return ErrorValue(WrapperDateName, value, "valid calendar date")
+			}
+			return nil
+		}
+
+		return wrapper.set(v, value, discard)
+
+	case time.Time:
+		return wrapper.set(DateOf(v), value, discard)
+
+	case string:
+		if v == "" {
+			wrapper.Discard()
+			if !discard {
+				return ErrorNil(WrapperDateName)
+			}
+			return nil
+		}
+
+		layouts := wrapper.layouts
+		if len(layouts) == 0 {
+			layouts = []string{WrapperDateLayout}
+		}
+
+		parsed, err := parseLayouts(layouts, v)
+		if err != nil {
+			wrapper.Discard()
+			if !discard {
+				return ErrorValue(WrapperDateName, value, strings.Join(layouts, ", "))
+			}
+			return nil
+		}
+
+		return wrapper.set(DateOf(parsed), value, discard)
+
+	default:
+		wrapper.Discard()
+		if !discard {
+			return ErrorType(WrapperDateName, value)
+		}
+	}
+
+	return nil
+}
+
+// set validates the date against the configured constraints before storing it.
+func (wrapper *WrapperDate) set(date Date, value any, discard bool) error {
+	if err := wrapper.checkDate(date); err != nil {
+		wrapper.Discard()
+		if !discard {
+			return ErrorValue(WrapperDateName, value, err.Error())
+		}
+		return nil
+	}
+
+	wrapper.Value = date
+
+	return nil
+}
+
+func (wrapper *WrapperDate) Unwrap() string {
+	if wrapper.IsDiscarded() {
+		return ""
+	}
+
+	return wrapper.Value.String()
+}
+
+func (wrapper *WrapperDate) UnwrapAny() any {
+	return wrapper.Unwrap()
+}
+
+func (wrapper *WrapperDate) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(wrapper)
+}
+
+func (wrapper *WrapperDate) UnmarshalJSON(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalJSON(data, wrapper)
+}
+
+func (wrapper *WrapperDate) MarshalText() ([]byte, error) {
+	return MarshalText(wrapper)
+}
+
+func (wrapper *WrapperDate) UnmarshalText(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalText(data, wrapper)
+}
+
+func (wrapper *WrapperDate) Scan(src any) error {
+	if wrapper == nil {
+		return fmt.Errorf("scan into nil wrapper")
+	}
+
+	return Scan(src, wrapper)
+}
+
+func (wrapper *WrapperDate) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return MarshalXML(encoder, start, wrapper)
+}
+
+func (wrapper *WrapperDate) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXML(decoder, start, wrapper)
+}
+
+func (wrapper *WrapperDate) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return MarshalXMLAttr(name, wrapper)
+}
+
+func (wrapper *WrapperDate) UnmarshalXMLAttr(attr xml.Attr) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXMLAttr(attr, wrapper)
+}
+
+func (wrapper *WrapperDate) Set(value string) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return Set(value, wrapper)
+}
+
+func (wrapper *WrapperDate) String() string {
+	return String(wrapper)
+}
+
+func (wrapper *WrapperDate) Schema() Schema {
+	return Schema{Type: "string", Format: "date", Examples: SchemaExamples(WrapperDateExample, wrapper)}
+}
+
+// parseLayouts tries the layouts in order and returns the first successful result or the last error.
+func parseLayouts(layouts []string, value string) (time.Time, error) {
+	var err error
+	for _, layout := range layouts {
+		var parsed time.Time
+		if parsed, err = time.Parse(layout, value); err == nil {
+			return parsed, nil
+		}
+	}
+
+	return time.Time{}, err
+}
+
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,160 @@
+package wrappers
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+	"time"
+)
+
+// TestWrapperDate_Wrap tests the Wrap method of WrapperDate.
+func TestWrapperDate_Wrap(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       any
+		layouts     []string
+		discard     bool
+		want        string
+		wantError   bool
+		wantDiscard bool
+	}{
+		{
+			name:  "Wrap valid date",
+			input: "2024-01-31",
+			want:  "2024-01-31",
+		},
+		{
+			name:  "Wrap leap day",
+			input: "2024-02-29",
+			want:  "2024-02-29",
+		},
+		{
+			name:        "Wrap leap day in common year",
+			input:       "2023-02-29",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap invalid month length",
+			input:       "2024-04-31",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap invalid month length with discard",
+			input:       "2024-04-31",
+			discard:     true,
+			wantError:   false,
+			wantDiscard: true,
+		},
+		{
+			name:    "Wrap custom layout",
+			input:   "29.02.2024",
+			layouts: []string{time.DateOnly, "02.01.2006"},
+			want:    "2024-02-29",
+		},
+		{
+			name:  "Wrap Date",
+			input: Date{Year: 2024, Month: time.December, Day: 24},
+			want:  "2024-12-24",
+		},
+		{
+			name:        "Wrap invalid Date",
+			input:       Date{Year: 2024, Month: time.February, Day: 30},
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:  "Wrap time.Time",
+			input: time.Date(2024, 3, 1, 23, 30, 0, 0, time.FixedZone("", -5*3600)),
+			want:  "2024-03-01",
+		},
+		{
+			name:        "Wrap nil",
+			input:       nil,
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap unsupported type",
+			input:       20240229,
+			wantError:   true,
+			wantDiscard: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapper := New[*WrapperDate]()
+			wrapper.SetLayouts(tt.layouts)
+
+			err := wrapper.Wrap(tt.input, tt.discard)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("Wrap() error = %v, wantError %v", err, tt.wantError)
+			}
+
+			if wrapper.IsDiscarded() != tt.wantDiscard {
+				t.Errorf("IsDiscarded() = %v, want %v", wrapper.IsDiscarded(), tt.wantDiscard)
+			}
+
+			if wrapper.Unwrap() != tt.want {
+				t.Errorf("Unwrap() = %v, want %v", wrapper.Unwrap(), tt.want)
+			}
+		})
+	}
+}
+
+// TestDate_Compare tests ordering of dates.
+func TestDate_Compare(t *testing.T) {
+	dates := []Date{
+		{Year: 2024, Month: time.March, Day: 1},
+		{Year: 2023, Month: time.December, Day: 31},
+		{Year: 2024, Month: time.February, Day: 29},
+	}
+
+	slices.SortFunc(dates, Date.Compare)
+
+	want := []string{"2023-12-31", "2024-02-29", "2024-03-01"}
+	for i, date := range dates {
+		if date.String() != want[i] {
+			t.Errorf("dates[%d] = %v, want %v", i, date, want[i])
+		}
+	}
+
+	if !dates[0].Before(dates[1]) || !dates[2].After(dates[1]) {
+		t.Errorf("Before() / After() returned unexpected results")
+	}
+
+	if dates[1].AddDays(1) != dates[2] {
+		t.Errorf("AddDays() = %v, want %v", dates[1].AddDays(1), dates[2])
+	}
+}
+
+// TestWrapperDate_JSON tests JSON marshalling and unmarshalling of WrapperDate.
+func TestWrapperDate_JSON(t *testing.T) {
+	type Person struct {
+		Birthday *WrapperDate `json:"birthday"`
+	}
+
+	var person Person
+	if err := json.Unmarshal([]byte(`{"birthday":"1990-07-15"}`), &person); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if person.Birthday.Get() != (Date{Year: 1990, Month: time.July, Day: 15}) {
+		t.Errorf("Get() = %v", person.Birthday.Get())
+	}
+
+	data, err := json.Marshal(&person)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	if string(data) != `{"birthday":"1990-07-15"}` {
+		t.Errorf("Marshalled JSON = %v", string(data))
+	}
+
+	if err := json.Unmarshal([]byte(`{"birthday":"1990-13-15"}`), &person); err == nil {
+		t.Errorf("Unmarshal() expected error for invalid date")
+	}
+}
@@ -0,0 +1,413 @@
+package wrappers
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net"
+	"net/mail"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+)
+
+const (
+	WrapperEmailName    Name   = "WrapperEmail"
+	WrapperEmailExample string = "alice@example.com"
+
+	wrapperEmailMaxLocal  int = 64  // RFC 5321 limit for the local part.
+	wrapperEmailMaxLength int = 254 // RFC 5321 limit for the forward path without the angle brackets.
+	wrapperEmailMaxDomain int = 253 // RFC 1035 limit for a domain name.
+	wrapperEmailMaxLabel  int = 63  // RFC 1035 limit for a single domain label.
+)
+
+// WrapperEmail validates email addresses using net/mail (RFC 5322) with additional domain validation.
+// Quoted local parts ("john doe"@example.com) and IP literal domains (alice@[192.0.2.1]) are supported.
+// The wrapped value is always the bare address without a display name.
+type WrapperEmail struct {
+	Wrapper[string, string]
+	allowDisplayName bool                // If true, addresses with a display name ("Alice <alice@example.com>") are accepted.
+	allowIDN         bool                // If true, internationalized domains are accepted and stored in their punycode (ASCII) form.
+	lowercaseDomain  bool                // If true, the domain is stored in lowercase.
+	blockedDomains   map[string]struct{} // Domains (and their subdomains) that are rejected, e.g. disposable email providers.
+	displayName      string
+}
+
+var _ WrapperProvider = (*WrapperEmail)(nil) // Ensure that WrapperEmail implements WrapperProvider.
+
+// SetAllowDisplayName configures whether addresses with a display name such as "Alice <alice@example.com>" are accepted.
+func (wrapper *WrapperEmail) SetAllowDisplayName(allow bool) {
+	wrapper.allowDisplayName = allow
+}
+
+// SetAllowIDN configures whether internationalized domains such as "bücher.de" are accepted. They are stored as punycode ("xn--bcher-kva.de").
+func (wrapper *WrapperEmail) SetAllowIDN(allow bool) {
+	wrapper.allowIDN = allow
+}
+
+// SetLowercaseDomain configures whether the domain of the address is lowercased. The local part is never modified as it may be case sensitive.
+func (wrapper *WrapperEmail) SetLowercaseDomain(lowercase bool) {
+	wrapper.lowercaseDomain = lowercase
+}
+
+// SetBlockedDomains sets the domains that are rejected during wrapping. Subdomains of blocked domains are rejected as well.
+// This is intended for lists of disposable email providers or otherwise unwanted domains.
+func (wrapper *WrapperEmail) SetBlockedDomains(domains []string) {
+	wrapper.blockedDomains = make(map[string]struct{}, len(domains))
+	for _, domain := range domains {
+		wrapper.blockedDomains[strings.ToLower(strings.TrimSuffix(domain, "."))] = struct{}{}
+	}
+}
+
+func (wrapper *WrapperEmail) Get() string {
+	return wrapper.Value
+}
+
+func (wrapper *WrapperEmail) GetAny() any {
+	return wrapper.Get()
+}
+
+// DisplayName returns the display name of the address if one was given and display names are allowed.
+func (wrapper *WrapperEmail) DisplayName() string {
+	if wrapper.IsDiscarded() {
+		return ""
+	}
+
+	return wrapper.displayName
+}
+
+// LocalPart returns the part of the address before the "@".
+func (wrapper *WrapperEmail) LocalPart() string {
+	if wrapper.IsDiscarded() {
+		return ""
+	}
+
+	index := strings.LastIndex(wrapper.Value, "@")
+	if index < 0 {
+		return ""
+	}
+
+	return wrapper.Value[:index]
+}
+
+// Domain returns the part of the address after the "@".
+func (wrapper *WrapperEmail) Domain() string {
+	if wrapper.IsDiscarded() {
+		return ""
+	}
+
+	index := strings.LastIndex(wrapper.Value, "@")
+	if index < 0 {
+		return ""
+	}
+
+	return wrapper.Value[index+1:]
+}
+
+func (wrapper *WrapperEmail) Wrap(value any, discard bool) error {
+	switch v := value.(type) {
+	case nil:
+		wrapper.Discard()
+		if !discard {
+			return ErrorNil(WrapperEmailName)
+		}
+
+	case WrapperProvider:
+		if v.IsDiscarded() {
+			wrapper.Discard()
+			return nil
+		}
+
+		return wrapper.Wrap(v.UnwrapAny(), discard)
+
+	case string:
+		if v == "" {
+			wrapper.Discard()
+			if !discard {
+				return ErrorNil(WrapperEmailName)
+			}
+			return nil
+		}
+
+		address, displayName, err := wrapper.parse(v)
+		if err != nil {
+			wrapper.Discard()
+			if !discard {
+				return ErrorParse(WrapperEmailName, value, err)
+			}
+			return nil
+		}
+
+		wrapper.Value = address
+		wrapper.displayName = displayName
+
+	default:
+		wrapper.Discard()
+		if !discard {
+			return ErrorType(WrapperEmailName, value)
+		}
+	}
+
+	return nil
+}
+
+// parse validates an address and returns the normalized address and its display name.
+func (wrapper *WrapperEmail) parse(value string) (string, string, error) {
+	value = strings.TrimSpace(value)
+
+	parsed, err := mail.ParseAddress(value)
+	if err != nil {
+		return "", "", err
+	}
+
+	// Only a bare addr-spec is accepted without display names. Angle-addr forms and comments, which net/mail also reads as a name, are rejected.
+	if !wrapper.allowDisplayName && (parsed.Name != "" || emailHasDecoration(value)) {
+		return "", "", fmt.Errorf("display names are not allowed")
+	}
+
+	index := strings.LastIndex(parsed.Address, "@")
+	local, domain := parsed.Address[:index], parsed.Address[index+1:]
+
+	if len(local) > wrapperEmailMaxLocal {
+		return "", "", fmt.Errorf("local part exceeds %d characters", wrapperEmailMaxLocal)
+	}
+
+	if strings.HasPrefix(domain, "[") {
+		if err := emailValidateLiteral(domain); err != nil {
+			return "", "", err
+		}
+
+	} else {
+		if !isASCII(domain) {
+			if !wrapper.allowIDN {
+				return "", "", fmt.Errorf("internationalized domains are not allowed")
+			}
+
+			domain, err = emailToASCII(domain)
+			if err != nil {
+				return "", "", err
+			}
+		}
+
+		if err := emailValidateDomain(domain); err != nil {
+			return "", "", err
+		}
+
+		if wrapper.lowercaseDomain {
+			domain = strings.ToLower(domain)
+		}
+
+		if wrapper.isBlocked(domain) {
+			return "", "", fmt.Errorf("domain %s is blocked", domain)
+		}
+	}
+
+	// Let net/mail quote the local part again if required and strip the angle brackets it adds.
+	address := (&mail.Address{Address: local + "@" + domain}).String()
+	address = strings.TrimSuffix(strings.TrimPrefix(address, "<"), ">")
+
+	if len(address) > wrapperEmailMaxLength {
+		return "", "", fmt.Errorf("address exceeds %d characters", wrapperEmailMaxLength)
+	}
+
+	return address, parsed.Name, nil
+}
+
+// isBlocked checks if the domain or one of its parent domains is blocked.
+func (wrapper *WrapperEmail) isBlocked(domain string) bool {
+	if len(wrapper.blockedDomains) == 0 {
+		return false
+	}
+
+	domain = strings.ToLower(domain)
+	for {
+		if _, ok := wrapper.blockedDomains[domain]; ok {
+			return true
+		}
+
+		index := strings.Index(domain, ".")
+		if index < 0 {
+			return false
+		}
+
+		domain = domain[index+1:]
+	}
+}
+
+func (wrapper *WrapperEmail) Unwrap() string {
+	if wrapper.IsDiscarded() {
+		return ""
+	}
+
+	return wrapper.Value
+}
+
+func (wrapper *WrapperEmail) UnwrapAny() any {
+	return wrapper.Unwrap()
+}
+
+func (wrapper *WrapperEmail) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(wrapper)
+}
+
+func (wrapper *WrapperEmail) UnmarshalJSON(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalJSON(data, wrapper)
+}
+
+func (wrapper *WrapperEmail) MarshalText() ([]byte, error) {
+	return MarshalText(wrapper)
+}
+
+func (wrapper *WrapperEmail) UnmarshalText(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalText(data, wrapper)
+}
+
+func (wrapper *WrapperEmail) Scan(src any) error {
+	if wrapper == nil {
+		return fmt.Errorf("scan into nil wrapper")
+	}
+
+	return Scan(src, wrapper)
+}
+
+func (wrapper *WrapperEmail) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return MarshalXML(encoder, start, wrapper)
+}
+
+func (wrapper *WrapperEmail) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXML(decoder, start, wrapper)
+}
+
+func (wrapper *WrapperEmail) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return MarshalXMLAttr(name, wrapper)
+}
+
+func (wrapper *WrapperEmail) UnmarshalXMLAttr(attr xml.Attr) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXMLAttr(attr, wrapper)
+}
+
+func (wrapper *WrapperEmail) Set(value string) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return Set(value, wrapper)
+}
+
+func (wrapper *WrapperEmail) String() string {
+	return String(wrapper)
+}
+
+func (wrapper *WrapperEmail) Schema() Schema {
+	return Schema{Type: "string", Format: "email", Examples: SchemaExamples(WrapperEmailExample, wrapper)}
+}
+
+// emailValidateLiteral validates an address literal domain such as "[192.0.2.1]" or "[IPv6:2001:db8::1]".
+func emailValidateLiteral(domain string) error {
+	literal := strings.TrimSuffix(strings.TrimPrefix(domain, "["), "]")
+
+	if ipv6, ok := strings.CutPrefix(literal, "IPv6:"); ok {
+		if ip := net.ParseIP(ipv6); ip == nil || !strings.Contains(ipv6, ":") {
+			return fmt.Errorf("invalid IPv6 address literal %s", domain)
+		}
+		return nil
+	}
+
+	if ip := net.ParseIP(literal); ip == nil || ip.To4() == nil {
+		return fmt.Errorf("invalid IPv4 address literal %s", domain)
+	}
+
+	return nil
+}
+
+// emailValidateDomain validates an ASCII domain name according to the RFC 1035 preferred name syntax.
+func emailValidateDomain(domain string) error {
+	if len(domain) > wrapperEmailMaxDomain {
+		return fmt.Errorf("domain exceeds %d characters", wrapperEmailMaxDomain)
+	}
+
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return fmt.Errorf("domain %s has no top-level domain", domain)
+	}
+
+	for _, label := range labels {
+		if label == "" {
+			return fmt.Errorf("domain %s contains an empty label", domain)
+		}
+
+		if len(label) > wrapperEmailMaxLabel {
+			return fmt.Errorf("domain label %s exceeds %d characters", label, wrapperEmailMaxLabel)
+		}
+
+		if label[0] == '-' || label[len(label)-1] == '-' {
+			return fmt.Errorf("domain label %s starts or ends with a hyphen", label)
+		}
+
+		for _, char := range label {
+			if !(char >= 'a' && char <= 'z' || char >= 'A' && char <= 'Z' || char >= '0' && char <= '9' || char == '-') {
+				return fmt.Errorf("domain label %s contains invalid character %q", label, char)
+			}
+		}
+	}
+
+	tld := labels[len(labels)-1]
+	if strings.Trim(tld, "0123456789") == "" {
+		return fmt.Errorf("top-level domain %s is numeric", tld)
+	}
+
+	return nil
+}
+
+// emailToASCII converts an internationalized domain into its ASCII form using the IDNA lookup profile (UTS 46),
+// which normalizes, maps and validates the labels before punycode encoding them.
+func emailToASCII(domain string) (string, error) {
+	return idna.Lookup.ToASCII(domain)
+}
+
+// emailHasDecoration checks if the address contains an angle-addr or a comment outside of quoted strings.
+func emailHasDecoration(value string) bool {
+	quoted := false
+	for i := 0; i < len(value); i++ {
+		switch value[i] {
+		case '\\':
+			i++ // Skip the escaped character.
+
+		case '"':
+			quoted = !quoted
+
+		case '<', '(':
+			if !quoted {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func isASCII(value string) bool {
+	for i := 0; i < len(value); i++ {
+		if value[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+
+	return true
+}
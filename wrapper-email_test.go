@@ -0,0 +1,257 @@
+package wrappers
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestWrapperEmail_Wrap tests the Wrap method of WrapperEmail.
+func TestWrapperEmail_Wrap(t *testing.T) {
+	tests := []struct {
+		name             string
+		input            any
+		allowDisplayName bool
+		allowIDN         bool
+		lowercaseDomain  bool
+		blockedDomains   []string
+		discard          bool
+		want             string
+		wantDisplayName  string
+		wantError        bool
+		wantDiscard      bool
+	}{
+		{
+			name:        "Wrap valid address",
+			input:       "alice@example.com",
+			want:        "alice@example.com",
+			wantError:   false,
+			wantDiscard: false,
+		},
+		{
+			name:        "Wrap quoted local part",
+			input:       `"alice smith"@example.com`,
+			want:        `"alice smith"@example.com`,
+			wantError:   false,
+			wantDiscard: false,
+		},
+		{
+			name:        "Wrap IPv4 literal domain",
+			input:       "alice@[192.0.2.1]",
+			want:        "alice@[192.0.2.1]",
+			wantError:   false,
+			wantDiscard: false,
+		},
+		{
+			name:        "Wrap IPv6 literal domain",
+			input:       "alice@[IPv6:2001:db8::1]",
+			want:        "alice@[IPv6:2001:db8::1]",
+			wantError:   false,
+			wantDiscard: false,
+		},
+		{
+			name:        "Wrap invalid literal domain",
+			input:       "alice@[999.0.2.1]",
+			want:        "",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap consecutive dots",
+			input:       "alice..smith@example.com",
+			want:        "",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap leading hyphen label",
+			input:       "alice@-example.com",
+			want:        "",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap missing top-level domain",
+			input:       "alice@localhost",
+			want:        "",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap missing top-level domain with discard",
+			input:       "alice@localhost",
+			discard:     true,
+			want:        "",
+			wantError:   false,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap display name not allowed",
+			input:       "Alice <alice@example.com>",
+			want:        "",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap display name with trailing comment not allowed",
+			input:       "Alice <alice@example.com> (x)",
+			want:        "",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap comment as display name not allowed",
+			input:       "alice@example.com (Alice)",
+			want:        "",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap quoted local part with angle bracket",
+			input:       `"a<b"@example.com`,
+			want:        `"a<b"@example.com`,
+			wantError:   false,
+			wantDiscard: false,
+		},
+		{
+			name:             "Wrap display name allowed",
+			input:            "Alice <alice@example.com>",
+			allowDisplayName: true,
+			want:             "alice@example.com",
+			wantDisplayName:  "Alice",
+			wantError:        false,
+			wantDiscard:      false,
+		},
+		{
+			name:        "Wrap internationalized domain not allowed",
+			input:       "alice@bücher.de",
+			want:        "",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap internationalized domain allowed",
+			input:       "alice@bücher.de",
+			allowIDN:    true,
+			want:        "alice@xn--bcher-kva.de",
+			wantError:   false,
+			wantDiscard: false,
+		},
+		{
+			name:        "Wrap decomposed internationalized domain",
+			input:       "alice@bu\u0308cher.de",
+			allowIDN:    true,
+			want:        "alice@xn--bcher-kva.de",
+			wantError:   false,
+			wantDiscard: false,
+		},
+		{
+			name:        "Wrap invalid internationalized domain",
+			input:       "alice@\u0308bücher.de",
+			allowIDN:    true,
+			want:        "",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap punycode domain",
+			input:       "alice@xn--mnchen-3ya.de",
+			want:        "alice@xn--mnchen-3ya.de",
+			wantError:   false,
+			wantDiscard: false,
+		},
+		{
+			name:            "Wrap lowercased domain",
+			input:           "Alice@Example.COM",
+			lowercaseDomain: true,
+			want:            "Alice@example.com",
+			wantError:       false,
+			wantDiscard:     false,
+		},
+		{
+			name:           "Wrap blocked domain",
+			input:          "alice@mailinator.com",
+			blockedDomains: []string{"mailinator.com"},
+			want:           "",
+			wantError:      true,
+			wantDiscard:    true,
+		},
+		{
+			name:           "Wrap blocked subdomain",
+			input:          "alice@inbox.Mailinator.com",
+			blockedDomains: []string{"mailinator.com"},
+			want:           "",
+			wantError:      true,
+			wantDiscard:    true,
+		},
+		{
+			name:        "Wrap nil",
+			input:       nil,
+			want:        "",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap unsupported type",
+			input:       12345,
+			want:        "",
+			wantError:   true,
+			wantDiscard: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapper := New[*WrapperEmail]()
+			wrapper.SetAllowDisplayName(tt.allowDisplayName)
+			wrapper.SetAllowIDN(tt.allowIDN)
+			wrapper.SetLowercaseDomain(tt.lowercaseDomain)
+			wrapper.SetBlockedDomains(tt.blockedDomains)
+
+			err := wrapper.Wrap(tt.input, tt.discard)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("Wrap() error = %v, wantError %v", err, tt.wantError)
+			}
+
+			if wrapper.IsDiscarded() != tt.wantDiscard {
+				t.Errorf("IsDiscarded() = %v, want %v", wrapper.IsDiscarded(), tt.wantDiscard)
+			}
+
+			if wrapper.Unwrap() != tt.want {
+				t.Errorf("Unwrap() = %v, want %v", wrapper.Unwrap(), tt.want)
+			}
+
+			if wrapper.DisplayName() != tt.wantDisplayName {
+				t.Errorf("DisplayName() = %v, want %v", wrapper.DisplayName(), tt.wantDisplayName)
+			}
+		})
+	}
+}
+
+// TestWrapperEmail_JSON tests JSON marshalling and unmarshalling of WrapperEmail.
+func TestWrapperEmail_JSON(t *testing.T) {
+	type Data struct {
+		Email *WrapperEmail `json:"email"`
+	}
+
+	var data Data
+	if err := json.Unmarshal([]byte(`{"email":"alice@example.com"}`), &data); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if data.Email.LocalPart() != "alice" || data.Email.Domain() != "example.com" {
+		t.Errorf("LocalPart() = %v, Domain() = %v", data.Email.LocalPart(), data.Email.Domain())
+	}
+
+	result, err := json.Marshal(&data)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	if string(result) != `{"email":"alice@example.com"}` {
+		t.Errorf("Marshalled JSON = %v", string(result))
+	}
+
+	if err := json.Unmarshal([]byte(`{"email":"alice@-example.com"}`), &data); err == nil {
+		t.Errorf("Unmarshal() expected error for invalid address")
+	}
+}
@@ -1,12 +1,14 @@
 package wrappers
 
 import (
+	"encoding/xml"
 	"fmt"
 	"strconv"
 )
 
 const (
-	WrapperFloatName Name = "WrapperFloat"
+	WrapperFloatName    Name   = "WrapperFloat"
+	WrapperFloatExample string = "3.14, -0.5"
 )
 
 type WrapperFloat Wrapper[float64, float64]
@@ -100,3 +102,63 @@ func (wrapper *WrapperFloat) UnmarshalJSON(data []byte) error {
 
 	return UnmarshalJSON(data, wrapper)
 }
+
+func (wrapper *WrapperFloat) MarshalText() ([]byte, error) {
+	return MarshalText(wrapper)
+}
+
+func (wrapper *WrapperFloat) UnmarshalText(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalText(data, wrapper)
+}
+
+func (wrapper *WrapperFloat) Scan(src any) error {
+	if wrapper == nil {
+		return fmt.Errorf("scan into nil wrapper")
+	}
+
+	return Scan(src, wrapper)
+}
+
+func (wrapper *WrapperFloat) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return MarshalXML(encoder, start, wrapper)
+}
+
+func (wrapper *WrapperFloat) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXML(decoder, start, wrapper)
+}
+
+func (wrapper *WrapperFloat) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return MarshalXMLAttr(name, wrapper)
+}
+
+func (wrapper *WrapperFloat) UnmarshalXMLAttr(attr xml.Attr) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXMLAttr(attr, wrapper)
+}
+
+func (wrapper *WrapperFloat) Set(value string) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return Set(value, wrapper)
+}
+
+func (wrapper *WrapperFloat) String() string {
+	return String(wrapper)
+}
+
+func (wrapper *WrapperFloat) Schema() Schema {
+	return Schema{Type: "number", Format: "double", Examples: SchemaExamples(WrapperFloatExample, wrapper)}
+}
@@ -1,12 +1,16 @@
 package wrappers
 
 import (
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"math"
 	"strconv"
 )
 
 const (
-	WrapperIntName Name = "WrapperInt"
+	WrapperIntName    Name   = "WrapperInt"
+	WrapperIntExample string = "42, -7"
 )
 
 type WrapperInt Wrapper[int64, int64]
@@ -99,3 +103,69 @@ func (wrapper *WrapperInt) UnmarshalJSON(data []byte) error {
 
 	return UnmarshalJSON(data, wrapper)
 }
+
+func (wrapper *WrapperInt) MarshalText() ([]byte, error) {
+	return MarshalText(wrapper)
+}
+
+func (wrapper *WrapperInt) UnmarshalText(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalText(data, wrapper)
+}
+
+func (wrapper *WrapperInt) Scan(src any) error {
+	if wrapper == nil {
+		return fmt.Errorf("scan into nil wrapper")
+	}
+
+	return Scan(src, wrapper)
+}
+
+func (wrapper *WrapperInt) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return MarshalXML(encoder, start, wrapper)
+}
+
+func (wrapper *WrapperInt) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXML(decoder, start, wrapper)
+}
+
+func (wrapper *WrapperInt) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return MarshalXMLAttr(name, wrapper)
+}
+
+func (wrapper *WrapperInt) UnmarshalXMLAttr(attr xml.Attr) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXMLAttr(attr, wrapper)
+}
+
+func (wrapper *WrapperInt) Set(value string) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return Set(value, wrapper)
+}
+
+func (wrapper *WrapperInt) String() string {
+	return String(wrapper)
+}
+
+func (wrapper *WrapperInt) Schema() Schema {
+	return Schema{
+		Type:     "integer",
+		Format:   "int64",
+		Minimum:  json.Number(strconv.FormatInt(math.MinInt64, 10)),
+		Maximum:  json.Number(strconv.FormatInt(math.MaxInt64, 10)),
+		Examples: SchemaExamples(WrapperIntExample, wrapper),
+	}
+}
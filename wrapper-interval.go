@@ -0,0 +1,375 @@
+package wrappers
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	WrapperIntervalName    Name   = "WrapperInterval"
+	WrapperIntervalExample string = "2024-01-01T00:00:00Z/2024-01-02T00:00:00Z, 2024-01-01T10:00:00Z/PT2H, P1D/2024-01-02T00:00:00Z"
+)
+
+// Interval is a span of time from Start (inclusive) to End (exclusive).
+type Interval struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Duration returns the length of the interval, or zero if the wrapper is discarded.
+func (interval Interval) Duration() time.Duration {
+	return interval.End.Sub(interval.Start)
+}
+
+// Contains checks if the time lies within the interval. The start is inclusive and the end exclusive.
+func (interval Interval) Contains(value time.Time) bool {
+	return !value.Before(interval.Start) && value.Before(interval.End)
+}
+
+// String returns the interval in ISO 8601 "start/end" notation.
+func (interval Interval) String() string {
+	return interval.Start.Format(time.RFC3339Nano) + "/" + interval.End.Format(time.RFC3339Nano)
+}
+
+// WrapperInterval wraps a time interval. Strings are accepted as ISO 8601 intervals in "start/end", "start/duration" and "duration/end" notation.
+// Objects are accepted with "start" and "end" keys. The end has to be after the start. Times are parsed like WrapperTimeISO8601.
+type WrapperInterval struct {
+	Wrapper[Interval, string]
+	TimeConstraints               // Constraints applied to both the start and the end.
+	minDuration     time.Duration // If positive, the interval may not be shorter than this.
+	maxDuration     time.Duration // If positive, the interval may not be longer than this.
+}
+
+var _ WrapperProvider = (*WrapperInterval)(nil) // Ensure that WrapperInterval implements WrapperProvider.
+
+// SetMinDuration requires the interval to be at least the given length.
+func (wrapper *WrapperInterval) SetMinDuration(duration time.Duration) {
+	wrapper.minDuration = duration
+}
+
+// SetMaxDuration requires the interval to be at most the given length.
+func (wrapper *WrapperInterval) SetMaxDuration(duration time.Duration) {
+	wrapper.maxDuration = duration
+}
+
+func (wrapper *WrapperInterval) Get() Interval {
+	return wrapper.Value
+}
+
+func (wrapper *WrapperInterval) GetAny() any {
+	return wrapper.Get()
+}
+
+// Start returns the inclusive start of the interval, or the zero time if the wrapper is discarded.
+func (wrapper *WrapperInterval) Start() time.Time {
+	if wrapper.IsDiscarded() {
+		return time.Time{}
+	}
+
+	return wrapper.Value.Start
+}
+
+// End returns the exclusive end of the interval, or the zero time if the wrapper is discarded.
+func (wrapper *WrapperInterval) End() time.Time {
+	if wrapper.IsDiscarded() {
+		return time.Time{}
+	}
+
+	return wrapper.Value.End
+}
+
+// Duration returns the length of the interval, or zero if the wrapper is discarded.
+func (wrapper *WrapperInterval) Duration() time.Duration {
+	if wrapper.IsDiscarded() {
+		return 0
+	}
+
+	return wrapper.Value.Duration()
+}
+
+// Contains checks if the time lies within the interval. Discarded intervals contain nothing.
+func (wrapper *WrapperInterval) Contains(value time.Time) bool {
+	if wrapper.IsDiscarded() {
+		return false
+	}
+
+	return wrapper.Value.Contains(value)
+}
+
+func (wrapper *WrapperInterval) Wrap(value any, discard bool) error {
+	switch v := value.(type) {
+	case nil:
+		wrapper.Discard()
+		if !discard {
+			return ErrorNil(WrapperIntervalName)
+		}
+
+	case WrapperProvider:
+		if v.IsDiscarded() {
+			wrapper.Discard()
+			return nil
+		}
+
+		return wrapper.Wrap(v.UnwrapAny(), discard)
+
+	case Interval:
+		return wrapper.set(v, value, discard)
+
+	case map[string]any:
+		interval, err := parseIntervalObject(v)
+		if err != nil {
+			wrapper.Discard()
+			if !discard {
+				return ErrorParse(WrapperIntervalName, value, err)
+			}
+			return nil
+		}
+
+		return wrapper.set(interval, value, discard)
+
+	case string:
+		if v == "" {
+			wrapper.Discard()
+			if !discard {
+				return ErrorNil(WrapperIntervalName)
+			}
+			return nil
+		}
+
+		interval, err := ParseISO8601Interval(v)
+		if err != nil {
+			wrapper.Discard()
+			if !discard {
+				return ErrorParse(WrapperIntervalName, value, err)
+			}
+			return nil
+		}
+
+		return wrapper.set(interval, value, discard)
+
+	default:
+		wrapper.Discard()
+		if !discard {
+			return ErrorType(WrapperIntervalName, value)
+		}
+	}
+
+	return nil
+}
+
+// set validates the ordering, length and time constraints of the interval before storing it.
+func (wrapper *WrapperInterval) set(interval Interval, value any, discard bool) error {
+	if err := wrapper.check(interval); err != nil {
+		wrapper.Discard()
+		if !discard {
+			return ErrorValue(WrapperIntervalName, value, err.Error())
+		}
+		return nil
+	}
+
+	wrapper.Value = interval
+
+	return nil
+}
+
+func (wrapper *WrapperInterval) check(interval Interval) error {
+	if !interval.End.After(interval.Start) {
+		return fmt.Errorf("end after start")
+	}
+
+	duration := interval.Duration()
+
+	if wrapper.minDuration > 0 && duration < wrapper.minDuration {
+		return fmt.Errorf("interval of at least %s", wrapper.minDuration)
+	}
+
+	if wrapper.maxDuration > 0 && duration > wrapper.maxDuration {
+		return fmt.Errorf("interval of at most %s", wrapper.maxDuration)
+	}
+
+	if err := wrapper.checkTime(interval.Start); err != nil {
+		return fmt.Errorf("start %w", err)
+	}
+
+	if err := wrapper.checkTime(interval.End); err != nil {
+		return fmt.Errorf("end %w", err)
+	}
+
+	return nil
+}
+
+func (wrapper *WrapperInterval) Unwrap() string {
+	if wrapper.IsDiscarded() {
+		return ""
+	}
+
+	return wrapper.Value.String()
+}
+
+func (wrapper *WrapperInterval) UnwrapAny() any {
+	return wrapper.Unwrap()
+}
+
+func (wrapper *WrapperInterval) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(wrapper)
+}
+
+func (wrapper *WrapperInterval) UnmarshalJSON(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalJSON(data, wrapper)
+}
+
+func (wrapper *WrapperInterval) MarshalText() ([]byte, error) {
+	return MarshalText(wrapper)
+}
+
+func (wrapper *WrapperInterval) UnmarshalText(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalText(data, wrapper)
+}
+
+func (wrapper *WrapperInterval) Scan(src any) error {
+	if wrapper == nil {
+		return fmt.Errorf("scan into nil wrapper")
+	}
+
+	return Scan(src, wrapper)
+}
+
+func (wrapper *WrapperInterval) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return MarshalXML(encoder, start, wrapper)
+}
+
+func (wrapper *WrapperInterval) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXML(decoder, start, wrapper)
+}
+
+func (wrapper *WrapperInterval) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return MarshalXMLAttr(name, wrapper)
+}
+
+func (wrapper *WrapperInterval) UnmarshalXMLAttr(attr xml.Attr) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXMLAttr(attr, wrapper)
+}
+
+func (wrapper *WrapperInterval) Set(value string) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return Set(value, wrapper)
+}
+
+func (wrapper *WrapperInterval) String() string {
+	return String(wrapper)
+}
+
+func (wrapper *WrapperInterval) Schema() Schema {
+	return Schema{Type: "string", Examples: SchemaExamples(WrapperIntervalExample, wrapper)}
+}
+
+// ParseISO8601Interval parses an ISO 8601 interval in "start/end", "start/duration" or "duration/end" notation.
+// The times are parsed with ParseISO8601 and the durations with ParseISO8601Duration. The ordering is not validated.
+func ParseISO8601Interval(value string) (Interval, error) {
+	first, second, found := strings.Cut(value, "/")
+	if !found {
+		return Interval{}, fmt.Errorf("ISO 8601 interval separated by \"/\", got %q", value)
+	}
+
+	firstIsDuration := strings.HasPrefix(first, "P")
+	secondIsDuration := strings.HasPrefix(second, "P")
+
+	switch {
+	case firstIsDuration && secondIsDuration:
+		return Interval{}, fmt.Errorf("ISO 8601 interval with at least one time, got %q", value)
+
+	case firstIsDuration:
+		duration, err := ParseISO8601Duration(first)
+		if err != nil {
+			return Interval{}, err
+		}
+
+		end, err := ParseISO8601(second)
+		if err != nil {
+			return Interval{}, err
+		}
+
+		return Interval{Start: end.Add(-duration), End: end}, nil
+
+	case secondIsDuration:
+		start, err := ParseISO8601(first)
+		if err != nil {
+			return Interval{}, err
+		}
+
+		duration, err := ParseISO8601Duration(second)
+		if err != nil {
+			return Interval{}, err
+		}
+
+		return Interval{Start: start, End: start.Add(duration)}, nil
+
+	default:
+		start, err := ParseISO8601(first)
+		if err != nil {
+			return Interval{}, err
+		}
+
+		end, err := ParseISO8601(second)
+		if err != nil {
+			return Interval{}, err
+		}
+
+		return Interval{Start: start, End: end}, nil
+	}
+}
+
+// parseIntervalObject parses an object with "start" and "end" keys holding ISO 8601 strings or time.Time values.
+func parseIntervalObject(object map[string]any) (Interval, error) {
+	for key := range object {
+		if key != "start" && key != "end" {
+			return Interval{}, fmt.Errorf("unknown key %q", key)
+		}
+	}
+
+	start, err := parseIntervalTime(object, "start")
+	if err != nil {
+		return Interval{}, err
+	}
+
+	end, err := parseIntervalTime(object, "end")
+	if err != nil {
+		return Interval{}, err
+	}
+
+	return Interval{Start: start, End: end}, nil
+}
+
+func parseIntervalTime(object map[string]any, key string) (time.Time, error) {
+	switch v := object[key].(type) {
+	case nil:
+		return time.Time{}, fmt.Errorf("missing %q", key)
+	case time.Time:
+		return v, nil
+	case string:
+		return ParseISO8601(v)
+	default:
+		return time.Time{}, fmt.Errorf("%q of type %T", key, v)
+	}
+}
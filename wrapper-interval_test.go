@@ -0,0 +1,273 @@
+package wrappers
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// TestWrapperInterval_Wrap tests the Wrap method of WrapperInterval.
+func TestWrapperInterval_Wrap(t *testing.T) {
+	start := time.Date(2024, time.January, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		input       any
+		minDuration time.Duration
+		maxDuration time.Duration
+		discard     bool
+		want        string
+		wantError   bool
+		wantDiscard bool
+	}{
+		{
+			name:  "Wrap start and end",
+			input: "2024-01-01T00:00Z/2024-01-02T00:00Z",
+			want:  "2024-01-01T00:00:00Z/2024-01-02T00:00:00Z",
+		},
+		{
+			name:  "Wrap start and duration",
+			input: "2024-01-01T10:00:00Z/PT2H",
+			want:  "2024-01-01T10:00:00Z/2024-01-01T12:00:00Z",
+		},
+		{
+			name:  "Wrap duration and end",
+			input: "P1D/2024-01-02T00:00:00Z",
+			want:  "2024-01-01T00:00:00Z/2024-01-02T00:00:00Z",
+		},
+		{
+			name:  "Wrap offsets",
+			input: "2024-01-01T10:00:00+02:00/2024-01-01T12:00:00+02:00",
+			want:  "2024-01-01T10:00:00+02:00/2024-01-01T12:00:00+02:00",
+		},
+		{
+			name:  "Wrap object",
+			input: map[string]any{"start": "2024-01-01T10:00:00Z", "end": "2024-01-01T11:00:00Z"},
+			want:  "2024-01-01T10:00:00Z/2024-01-01T11:00:00Z",
+		},
+		{
+			name:  "Wrap Interval",
+			input: Interval{Start: start, End: start.Add(time.Hour)},
+			want:  "2024-01-01T10:00:00Z/2024-01-01T11:00:00Z",
+		},
+		{
+			name:        "Wrap end before start",
+			input:       "2024-01-02T00:00:00Z/2024-01-01T00:00:00Z",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap end equal to start",
+			input:       "2024-01-01T00:00:00Z/2024-01-01T00:00:00Z",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap end before start with discard",
+			input:       "2024-01-02T00:00:00Z/2024-01-01T00:00:00Z",
+			discard:     true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap two durations",
+			input:       "PT1H/PT2H",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap missing separator",
+			input:       "2024-01-01T00:00:00Z",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap object missing end",
+			input:       map[string]any{"start": "2024-01-01T10:00:00Z"},
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap object unknown key",
+			input:       map[string]any{"start": "2024-01-01T10:00:00Z", "end": "2024-01-01T11:00:00Z", "until": "x"},
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap shorter than minimum",
+			input:       "2024-01-01T10:00:00Z/PT15M",
+			minDuration: 30 * time.Minute,
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap longer than maximum",
+			input:       "2024-01-01T10:00:00Z/P8D",
+			maxDuration: 7 * 24 * time.Hour,
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap within maximum",
+			input:       "2024-01-01T10:00:00Z/P7D",
+			maxDuration: 7 * 24 * time.Hour,
+			want:        "2024-01-01T10:00:00Z/2024-01-08T10:00:00Z",
+		},
+		{
+			name:        "Wrap unsupported type",
+			input:       42,
+			wantError:   true,
+			wantDiscard: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapper := New[*WrapperInterval]()
+			wrapper.SetMinDuration(tt.minDuration)
+			wrapper.SetMaxDuration(tt.maxDuration)
+
+			err := wrapper.Wrap(tt.input, tt.discard)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("Wrap() error = %v, wantError %v", err, tt.wantError)
+			}
+
+			if wrapper.IsDiscarded() != tt.wantDiscard {
+				t.Errorf("IsDiscarded() = %v, want %v", wrapper.IsDiscarded(), tt.wantDiscard)
+			}
+
+			if !tt.wantDiscard && wrapper.Unwrap() != tt.want {
+				t.Errorf("Unwrap() = %v, want %v", wrapper.Unwrap(), tt.want)
+			}
+		})
+	}
+}
+
+// TestWrapperInterval_Accessors tests Start, End, Duration and Contains of WrapperInterval.
+func TestWrapperInterval_Accessors(t *testing.T) {
+	wrapper := New[*WrapperInterval]()
+	if err := wrapper.Wrap("2024-01-01T10:00:00Z/PT2H", false); err != nil {
+		t.Fatalf("Wrap() error = %v", err)
+	}
+
+	start := time.Date(2024, time.January, 1, 10, 0, 0, 0, time.UTC)
+
+	if !wrapper.Start().Equal(start) {
+		t.Errorf("Start() = %v, want %v", wrapper.Start(), start)
+	}
+
+	if !wrapper.End().Equal(start.Add(2 * time.Hour)) {
+		t.Errorf("End() = %v, want %v", wrapper.End(), start.Add(2*time.Hour))
+	}
+
+	if wrapper.Duration() != 2*time.Hour {
+		t.Errorf("Duration() = %v, want %v", wrapper.Duration(), 2*time.Hour)
+	}
+
+	contains := []struct {
+		value time.Time
+		want  bool
+	}{
+		{value: start.Add(-time.Nanosecond), want: false},
+		{value: start, want: true},
+		{value: start.Add(time.Hour), want: true},
+		{value: start.Add(2 * time.Hour), want: false},
+	}
+
+	for _, tt := range contains {
+		if got := wrapper.Contains(tt.value); got != tt.want {
+			t.Errorf("Contains(%v) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+
+	wrapper.Discard()
+
+	if !wrapper.Start().IsZero() || !wrapper.End().IsZero() || wrapper.Duration() != 0 || wrapper.Contains(start) {
+		t.Errorf("discarded Start() = %v, End() = %v, Duration() = %v, want zero values", wrapper.Start(), wrapper.End(), wrapper.Duration())
+	}
+}
+
+// TestWrapperInterval_Constraints tests that the time constraints are applied to both ends of WrapperInterval.
+func TestWrapperInterval_Constraints(t *testing.T) {
+	now := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		input     string
+		wantError bool
+	}{
+		{name: "Future interval", input: "2024-01-02T10:00:00Z/PT2H"},
+		{name: "Interval starting in the past", input: "2024-01-01T11:00:00Z/PT2H", wantError: true},
+		{name: "Past interval", input: "2023-12-31T10:00:00Z/PT2H", wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapper := New[*WrapperInterval]()
+			wrapper.SetClock(ClockFunc(func() time.Time { return now }))
+			wrapper.SetFuture(true)
+
+			err := wrapper.Wrap(tt.input, false)
+			if (err != nil) != tt.wantError {
+				t.Errorf("Wrap() error = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+// TestWrapperInterval_JSON tests JSON marshalling and unmarshalling of WrapperInterval within a struct.
+func TestWrapperInterval_JSON(t *testing.T) {
+	type Booking struct {
+		Window *WrapperInterval `json:"window"`
+	}
+
+	tests := []struct {
+		name      string
+		jsonInput string
+		want      string
+		wantError bool
+	}{
+		{
+			name:      "Unmarshal string",
+			jsonInput: `{"window":"2024-01-01T10:00:00Z/PT2H"}`,
+			want:      `{"window":"2024-01-01T10:00:00Z/2024-01-01T12:00:00Z"}`,
+		},
+		{
+			name:      "Unmarshal object",
+			jsonInput: `{"window":{"start":"2024-01-01T10:00:00Z","end":"2024-01-01T12:00:00Z"}}`,
+			want:      `{"window":"2024-01-01T10:00:00Z/2024-01-01T12:00:00Z"}`,
+		},
+		{
+			name:      "Unmarshal reversed object",
+			jsonInput: `{"window":{"start":"2024-01-01T12:00:00Z","end":"2024-01-01T10:00:00Z"}}`,
+			wantError: true,
+		},
+		{
+			name:      "Unmarshal null",
+			jsonInput: `{"window":null}`,
+			want:      `{"window":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var booking Booking
+			err := json.Unmarshal([]byte(tt.jsonInput), &booking)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("Unmarshal() error = %v, wantError %v", err, tt.wantError)
+			}
+
+			if tt.wantError {
+				return
+			}
+
+			data, err := json.Marshal(booking)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+
+			if string(data) != tt.want {
+				t.Errorf("Marshalled JSON = %v, want %v", string(data), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,403 @@
+package wrappers
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/biter777/countries"
+)
+
+const (
+	WrapperPhoneName    Name   = "WrapperPhone"
+	WrapperPhoneExample string = "+4930123456, +49 (30) 123-456, 030 123456"
+
+	wrapperPhoneMaxDigits int = 15 // E.164 limits numbers to 15 digits including the country calling code.
+	wrapperPhoneMinDigits int = 4  // Shortest national significant number accepted for calling codes without a known range.
+)
+
+// WrapperPhone normalizes phone numbers into their E.164 representation (e.g. "+4930123456").
+// International numbers ("+49 30 123456", "0049 30 123456") are resolved by their country calling code.
+// National numbers ("030 123456") are resolved using the country of a paired WrapperCountry or the configured default country.
+type WrapperPhone struct {
+	Wrapper[string, string]
+	country        countries.CountryCode // Default country used to resolve national numbers.
+	countryWrapper *WrapperCountry       // Sibling country wrapper used to resolve national numbers. Takes precedence over the default country.
+	callingCode    countries.CallCode
+}
+
+var _ WrapperProvider = (*WrapperPhone)(nil) // Ensure that WrapperPhone implements WrapperProvider.
+
+// SetCountry sets the default country used to resolve national numbers.
+func (wrapper *WrapperPhone) SetCountry(country countries.CountryCode) {
+	wrapper.country = country
+}
+
+// SetCountryWrapper pairs the phone wrapper with a country wrapper whose value is used to resolve national numbers.
+// The country wrapper has to be wrapped before the phone number. If it is discarded, the default country is used.
+func (wrapper *WrapperPhone) SetCountryWrapper(country *WrapperCountry) {
+	wrapper.countryWrapper = country
+}
+
+func (wrapper *WrapperPhone) Get() string {
+	return wrapper.Value
+}
+
+func (wrapper *WrapperPhone) GetAny() any {
+	return wrapper.Get()
+}
+
+// CallingCode returns the country calling code of the wrapped number.
+func (wrapper *WrapperPhone) CallingCode() countries.CallCode {
+	if wrapper.IsDiscarded() {
+		return countries.CallCodeUnknown
+	}
+
+	return wrapper.callingCode
+}
+
+// NationalNumber returns the national significant number of the wrapped number, without the country calling code or trunk prefix.
+func (wrapper *WrapperPhone) NationalNumber() string {
+	if wrapper.IsDiscarded() || wrapper.Value == "" {
+		return ""
+	}
+
+	return strings.TrimPrefix(wrapper.Value, wrapper.callingCode.String())
+}
+
+func (wrapper *WrapperPhone) Wrap(value any, discard bool) error {
+	switch v := value.(type) {
+	case nil:
+		wrapper.Discard()
+		if !discard {
+			return ErrorNil(WrapperPhoneName)
+		}
+
+	case WrapperProvider:
+		if v.IsDiscarded() {
+			wrapper.Discard()
+			return nil
+		}
+
+		return wrapper.Wrap(v.UnwrapAny(), discard)
+
+	case string:
+		if v == "" {
+			wrapper.Discard()
+			if !discard {
+				return ErrorNil(WrapperPhoneName)
+			}
+			return nil
+		}
+
+		callingCode, national, err := wrapper.parse(v)
+		if err != nil {
+			wrapper.Discard()
+			if !discard {
+				return ErrorParse(WrapperPhoneName, value, err)
+			}
+			return nil
+		}
+
+		wrapper.callingCode = callingCode
+		wrapper.Value = callingCode.String() + national
+
+	default:
+		wrapper.Discard()
+		if !discard {
+			return ErrorType(WrapperPhoneName, value)
+		}
+	}
+
+	return nil
+}
+
+// parse strips the formatting of a phone number and splits it into its country calling code and national significant number.
+func (wrapper *WrapperPhone) parse(value string) (countries.CallCode, string, error) {
+	value = strings.TrimSpace(value)
+
+	international := false
+	switch {
+	case strings.HasPrefix(value, "+"):
+		international = true
+		value = value[1:]
+
+	case strings.HasPrefix(value, "00"):
+		international = true
+		value = value[2:]
+	}
+
+	// International numbers are often written with the trunk prefix in parentheses, e.g. "+49 (0)30 123456".
+	if international {
+		value = strings.Replace(value, "(0)", "", 1)
+	}
+
+	var digits strings.Builder
+	for _, char := range value {
+		switch {
+		case char >= '0' && char <= '9':
+			digits.WriteRune(char)
+
+		case strings.ContainsRune(" -./()\u00a0", char):
+			continue
+
+		default:
+			return countries.CallCodeUnknown, "", fmt.Errorf("unexpected character %q", char)
+		}
+	}
+
+	number := digits.String()
+	if number == "" {
+		return countries.CallCodeUnknown, "", fmt.Errorf("no digits")
+	}
+
+	var callingCode countries.CallCode
+	var national string
+
+	if international {
+		for length := 1; length <= 3 && length < len(number); length++ {
+			code, _ := strconv.ParseInt(number[:length], 10, 64)
+			if countries.CallCode(code).IsValid() {
+				callingCode = countries.CallCode(code)
+				national = number[length:]
+				break
+			}
+		}
+
+		if callingCode == countries.CallCodeUnknown {
+			return countries.CallCodeUnknown, "", fmt.Errorf("unknown country calling code")
+		}
+
+		// The trunk prefix is also commonly kept without parentheses, e.g. "+49 030 123456". It is only stripped for the calling codes
+		// in phoneInternationalTrunkPrefixes, since in other countries the same digits can start a national significant number.
+		if phoneInternationalTrunkPrefixes[callingCode] {
+			national = strings.TrimPrefix(national, phoneTrunkPrefix(callingCode))
+		}
+
+	} else {
+		country := wrapper.country
+		if wrapper.countryWrapper != nil && !wrapper.countryWrapper.IsDiscarded() && wrapper.countryWrapper.Get() != countries.Unknown {
+			country = wrapper.countryWrapper.Get()
+		}
+
+		if country == countries.Unknown {
+			return countries.CallCodeUnknown, "", fmt.Errorf("national number without country")
+		}
+
+		callingCode = phoneCallingCode(country)
+		if callingCode == countries.CallCodeUnknown {
+			return countries.CallCodeUnknown, "", fmt.Errorf("no country calling code for %s", country.Alpha2())
+		}
+
+		national = number
+
+		// Strip the trunk prefix used for national dialing.
+		if trunk := phoneTrunkPrefix(callingCode); trunk != "" {
+			national = strings.TrimPrefix(national, trunk)
+		}
+	}
+
+	minimum, maximum := wrapperPhoneMinDigits, wrapperPhoneMaxDigits-len(strconv.FormatInt(int64(callingCode), 10))
+	if lengths, ok := phoneNumberLengths[callingCode]; ok {
+		minimum, maximum = lengths[0], lengths[1]
+	}
+
+	if len(national) < minimum || len(national) > maximum {
+		if minimum == maximum {
+			return countries.CallCodeUnknown, "", fmt.Errorf("expected %d digits after %s, got %d", minimum, callingCode, len(national))
+		}
+		return countries.CallCodeUnknown, "", fmt.Errorf("expected %d to %d digits after %s, got %d", minimum, maximum, callingCode, len(national))
+	}
+
+	return callingCode, national, nil
+}
+
+func (wrapper *WrapperPhone) Unwrap() string {
+	if wrapper.IsDiscarded() {
+		return ""
+	}
+
+	return wrapper.Value
+}
+
+func (wrapper *WrapperPhone) UnwrapAny() any {
+	return wrapper.Unwrap()
+}
+
+func (wrapper *WrapperPhone) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(wrapper)
+}
+
+func (wrapper *WrapperPhone) UnmarshalJSON(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalJSON(data, wrapper)
+}
+
+func (wrapper *WrapperPhone) MarshalText() ([]byte, error) {
+	return MarshalText(wrapper)
+}
+
+func (wrapper *WrapperPhone) UnmarshalText(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalText(data, wrapper)
+}
+
+func (wrapper *WrapperPhone) Scan(src any) error {
+	if wrapper == nil {
+		return fmt.Errorf("scan into nil wrapper")
+	}
+
+	return Scan(src, wrapper)
+}
+
+func (wrapper *WrapperPhone) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return MarshalXML(encoder, start, wrapper)
+}
+
+func (wrapper *WrapperPhone) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXML(decoder, start, wrapper)
+}
+
+func (wrapper *WrapperPhone) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return MarshalXMLAttr(name, wrapper)
+}
+
+func (wrapper *WrapperPhone) UnmarshalXMLAttr(attr xml.Attr) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXMLAttr(attr, wrapper)
+}
+
+func (wrapper *WrapperPhone) Set(value string) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return Set(value, wrapper)
+}
+
+func (wrapper *WrapperPhone) String() string {
+	return String(wrapper)
+}
+
+// Schema describes phone numbers in their normalized E.164 representation.
+func (wrapper *WrapperPhone) Schema() Schema {
+	return Schema{
+		Type:     "string",
+		Pattern:  fmt.Sprintf(`^\+[1-9][0-9]{%d,%d}$`, wrapperPhoneMinDigits, wrapperPhoneMaxDigits-1),
+		Examples: SchemaExamples(WrapperPhoneExample, wrapper),
+	}
+}
+
+// phoneCallingCode returns the country calling code for a country.
+// Some countries are listed with extended codes that include an area code (e.g. "+1242" for the Bahamas) which are reduced to the calling code itself.
+func phoneCallingCode(country countries.CountryCode) countries.CallCode {
+	for _, code := range country.CallCodes() {
+		digits := strconv.FormatInt(int64(code), 10)
+		for length := 1; length <= 3 && length <= len(digits); length++ {
+			prefix, _ := strconv.ParseInt(digits[:length], 10, 64)
+			if countries.CallCode(prefix).IsValid() {
+				return countries.CallCode(prefix)
+			}
+		}
+	}
+
+	return countries.CallCodeUnknown
+}
+
+// phoneTrunkPrefixes lists the national trunk prefixes that differ from the common "0". An empty prefix means the leading digits are part of the number.
+var phoneTrunkPrefixes = map[countries.CallCode]string{
+	1:   "1",
+	7:   "8",
+	36:  "06",
+	39:  "",
+	370: "8",
+	375: "8",
+}
+
+// phoneInternationalTrunkPrefixes lists the calling codes whose national significant numbers never start with their trunk prefix,
+// so the prefix can be stripped from international numbers as well. In other countries, such as Côte d'Ivoire (+225) and
+// San Marino (+378), a leading "0" is part of the number.
+var phoneInternationalTrunkPrefixes = map[countries.CallCode]bool{
+	1: true, 20: true, 27: true, 31: true, 32: true, 33: true, 36: true, 40: true, 41: true, 43: true, 44: true, 46: true, 49: true,
+	54: true, 55: true, 60: true, 61: true, 62: true, 63: true, 64: true, 66: true, 81: true, 82: true, 84: true, 86: true, 90: true,
+	91: true, 92: true, 234: true, 254: true, 353: true, 358: true, 359: true, 380: true, 381: true, 385: true, 386: true, 966: true,
+	971: true, 972: true,
+}
+
+// phoneTrunkPrefix returns the national trunk prefix of the country calling code, which is "0" unless listed in phoneTrunkPrefixes.
+func phoneTrunkPrefix(callingCode countries.CallCode) string {
+	if trunk, ok := phoneTrunkPrefixes[callingCode]; ok {
+		return trunk
+	}
+
+	return "0"
+}
+
+// phoneNumberLengths lists the minimum and maximum length of national significant numbers for common country calling codes.
+// Calling codes that are not listed accept anything between wrapperPhoneMinDigits and the E.164 maximum.
+var phoneNumberLengths = map[countries.CallCode][2]int{
+	1:   {10, 10},
+	7:   {10, 10},
+	20:  {8, 10},
+	27:  {9, 9},
+	30:  {10, 10},
+	31:  {9, 9},
+	32:  {8, 9},
+	33:  {9, 9},
+	34:  {9, 9},
+	36:  {8, 9},
+	39:  {6, 11},
+	40:  {9, 9},
+	41:  {9, 9},
+	43:  {4, 13},
+	44:  {7, 10},
+	45:  {8, 8},
+	46:  {7, 13},
+	47:  {5, 8},
+	48:  {9, 9},
+	49:  {6, 13},
+	51:  {8, 9},
+	52:  {10, 10},
+	54:  {10, 11},
+	55:  {10, 11},
+	56:  {9, 9},
+	57:  {8, 10},
+	60:  {8, 10},
+	61:  {9, 9},
+	62:  {8, 12},
+	63:  {8, 10},
+	64:  {8, 10},
+	65:  {8, 8},
+	66:  {8, 9},
+	81:  {9, 10},
+	82:  {8, 10},
+	84:  {9, 10},
+	86:  {10, 11},
+	90:  {10, 10},
+	91:  {10, 10},
+	92:  {9, 10},
+	351: {9, 9},
+	352: {4, 11},
+	353: {7, 9},
+	358: {5, 12},
+	380: {9, 9},
+	420: {9, 9},
+	421: {9, 9},
+	852: {8, 8},
+	971: {8, 9},
+	972: {8, 9},
+}
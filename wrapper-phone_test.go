@@ -0,0 +1,219 @@
+package wrappers
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/biter777/countries"
+)
+
+// TestWrapperPhone_Wrap tests the Wrap method of WrapperPhone.
+func TestWrapperPhone_Wrap(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       any
+		country     countries.CountryCode
+		discard     bool
+		want        string
+		wantError   bool
+		wantDiscard bool
+	}{
+		{
+			name:        "Wrap E.164 number",
+			input:       "+4930123456",
+			want:        "+4930123456",
+			wantError:   false,
+			wantDiscard: false,
+		},
+		{
+			name:        "Wrap formatted international number",
+			input:       "+49 (30) 1234-567",
+			want:        "+49301234567",
+			wantError:   false,
+			wantDiscard: false,
+		},
+		{
+			name:        "Wrap international number with trunk prefix",
+			input:       "+49 (0)30 1234567",
+			want:        "+49301234567",
+			wantError:   false,
+			wantDiscard: false,
+		},
+		{
+			name:        "Wrap international number with unbracketed trunk prefix",
+			input:       "+49 030 123456",
+			want:        "+4930123456",
+			wantError:   false,
+			wantDiscard: false,
+		},
+		{
+			name:        "Wrap international NANP number with trunk prefix",
+			input:       "+1 1 415 555 2671",
+			want:        "+14155552671",
+			wantError:   false,
+			wantDiscard: false,
+		},
+		{
+			name:        "Wrap international number with ambiguous trunk prefix",
+			input:       "+7 800 555 3535",
+			want:        "+78005553535",
+			wantError:   false,
+			wantDiscard: false,
+		},
+		{
+			name:        "Wrap international number with leading zero in Côte d'Ivoire",
+			input:       "+225 07 12 34 56 78",
+			want:        "+2250712345678",
+			wantError:   false,
+			wantDiscard: false,
+		},
+		{
+			name:        "Wrap international number with leading zero in San Marino",
+			input:       "+378 0549 123456",
+			want:        "+3780549123456",
+			wantError:   false,
+			wantDiscard: false,
+		},
+		{
+			name:        "Wrap international number with 00 prefix",
+			input:       "0044 20 7946 0958",
+			want:        "+442079460958",
+			wantError:   false,
+			wantDiscard: false,
+		},
+		{
+			name:        "Wrap national number with default country",
+			input:       "030 1234567",
+			country:     countries.DE,
+			want:        "+49301234567",
+			wantError:   false,
+			wantDiscard: false,
+		},
+		{
+			name:        "Wrap national number with NANP trunk prefix",
+			input:       "1 (415) 555-2671",
+			country:     countries.US,
+			want:        "+14155552671",
+			wantError:   false,
+			wantDiscard: false,
+		},
+		{
+			name:        "Wrap national number keeping leading zero",
+			input:       "06 1234 5678",
+			country:     countries.IT,
+			want:        "+390612345678",
+			wantError:   false,
+			wantDiscard: false,
+		},
+		{
+			name:        "Wrap national number without country",
+			input:       "030 1234567",
+			want:        "",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap number with unknown calling code",
+			input:       "+999 1234567",
+			want:        "",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap number with invalid length",
+			input:       "+1 415 555 26",
+			want:        "",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap number with invalid length with discard",
+			input:       "+1 415 555 26",
+			discard:     true,
+			want:        "",
+			wantError:   false,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap number with letters",
+			input:       "+49 30 CALL-NOW",
+			want:        "",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap nil",
+			input:       nil,
+			want:        "",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap unsupported type",
+			input:       4930123456,
+			want:        "",
+			wantError:   true,
+			wantDiscard: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapper := New[*WrapperPhone]()
+			wrapper.SetCountry(tt.country)
+
+			err := wrapper.Wrap(tt.input, tt.discard)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("Wrap() error = %v, wantError %v", err, tt.wantError)
+			}
+
+			if wrapper.IsDiscarded() != tt.wantDiscard {
+				t.Errorf("IsDiscarded() = %v, want %v", wrapper.IsDiscarded(), tt.wantDiscard)
+			}
+
+			if wrapper.Unwrap() != tt.want {
+				t.Errorf("Unwrap() = %v, want %v", wrapper.Unwrap(), tt.want)
+			}
+		})
+	}
+}
+
+// TestWrapperPhone_CountryWrapper tests resolving national numbers using a sibling WrapperCountry.
+func TestWrapperPhone_CountryWrapper(t *testing.T) {
+	type Contact struct {
+		Country *WrapperCountry `json:"country"`
+		Phone   *WrapperPhone   `json:"phone"`
+	}
+
+	contact := Contact{
+		Country: New[*WrapperCountry](),
+		Phone:   New[*WrapperPhone](),
+	}
+	contact.Phone.SetCountry(countries.US)
+	contact.Phone.SetCountryWrapper(contact.Country)
+
+	if err := json.Unmarshal([]byte(`{"country":"FR","phone":"01 23 45 67 89"}`), &contact); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if contact.Phone.Unwrap() != "+33123456789" {
+		t.Errorf("Unwrap() = %v, want %v", contact.Phone.Unwrap(), "+33123456789")
+	}
+
+	if contact.Phone.CallingCode() != countries.CallCode33 {
+		t.Errorf("CallingCode() = %v, want %v", contact.Phone.CallingCode(), countries.CallCode33)
+	}
+
+	if contact.Phone.NationalNumber() != "123456789" {
+		t.Errorf("NationalNumber() = %v, want %v", contact.Phone.NationalNumber(), "123456789")
+	}
+
+	data, err := json.Marshal(&contact)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	if string(data) != `{"country":"France","phone":"+33123456789"}` {
+		t.Errorf("Marshalled JSON = %v", string(data))
+	}
+}
@@ -0,0 +1,746 @@
+package wrappers
+
+import (
+	"encoding/xml"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	WrapperRecurrenceName    Name   = "WrapperRecurrence"
+	WrapperRecurrenceExample string = "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10, FREQ=MONTHLY;BYDAY=-1FR, FREQ=YEARLY;BYMONTH=3;BYMONTHDAY=1;UNTIL=20301231"
+)
+
+// RecurrenceFrequency is the FREQ part of a recurrence rule. Frequencies are ordered from the finest to the coarsest.
+type RecurrenceFrequency int
+
+const (
+	RecurrenceSecondly RecurrenceFrequency = iota
+	RecurrenceMinutely
+	RecurrenceHourly
+	RecurrenceDaily
+	RecurrenceWeekly
+	RecurrenceMonthly
+	RecurrenceYearly
+)
+
+var recurrenceFrequencies = []string{"SECONDLY", "MINUTELY", "HOURLY", "DAILY", "WEEKLY", "MONTHLY", "YEARLY"}
+
+func (frequency RecurrenceFrequency) String() string {
+	if frequency < RecurrenceSecondly || frequency > RecurrenceYearly {
+		return fmt.Sprintf("RecurrenceFrequency(%d)", int(frequency))
+	}
+
+	return recurrenceFrequencies[frequency]
+}
+
+var recurrenceWeekdays = map[string]time.Weekday{"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday, "TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday}
+
+var recurrenceByDay = regexp.MustCompile(`^([+-]?\d{1,2})?(SU|MO|TU|WE|TH|FR|SA)$`)
+
+// RecurrenceWeekday is an entry of the BYDAY part, e.g. "MO" or "-1FR". N is the occurrence of the weekday within the month or year, zero for every occurrence.
+type RecurrenceWeekday struct {
+	Weekday time.Weekday
+	N       int
+}
+
+// RecurrenceRule is a parsed RFC 5545 recurrence rule.
+// Leap seconds (BYSECOND=60) are not supported as time.Time cannot represent them.
+type RecurrenceRule struct {
+	Frequency  RecurrenceFrequency
+	Interval   int       // Defaults to 1.
+	Count      int       // Zero if unbounded by count.
+	Until      time.Time // Zero if unbounded by time. Date and floating values are stored in UTC and reinterpreted in the expansion location.
+	BySecond   []int
+	ByMinute   []int
+	ByHour     []int
+	ByDay      []RecurrenceWeekday
+	ByMonthDay []int
+	ByYearDay  []int
+	ByWeekNo   []int
+	ByMonth    []int
+	BySetPos   []int
+	WeekStart  time.Weekday // Defaults to Monday.
+
+	expression string
+	untilLocal bool // UNTIL was given as a date or floating date and time.
+	untilDate  bool // UNTIL was given as a date and includes the whole day.
+}
+
+// ParseRecurrenceRule parses an RFC 5545 recurrence rule such as "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10". An "RRULE:" prefix is accepted.
+// Parts that are not allowed for the frequency or contradict each other are rejected.
+func ParseRecurrenceRule(value string) (RecurrenceRule, error) {
+	expression := strings.TrimSpace(value)
+	if len(expression) >= 6 && strings.EqualFold(expression[:6], "RRULE:") {
+		expression = expression[6:]
+	}
+
+	rule := RecurrenceRule{Interval: 1, WeekStart: time.Monday, expression: expression}
+
+	seen := map[string]bool{}
+	for _, part := range strings.Split(expression, ";") {
+		key, value, found := strings.Cut(part, "=")
+		key = strings.ToUpper(key)
+		value = strings.ToUpper(value)
+
+		if !found || value == "" {
+			return RecurrenceRule{}, fmt.Errorf("recurrence rule part as KEY=VALUE, got %q", part)
+		}
+
+		if seen[key] {
+			return RecurrenceRule{}, fmt.Errorf("recurrence rule part %s only once", key)
+		}
+		seen[key] = true
+
+		var err error
+		switch key {
+		case "FREQ":
+			index := slices.Index(recurrenceFrequencies, value)
+			if index < 0 {
+				return RecurrenceRule{}, fmt.Errorf("recurrence frequency, got %q", value)
+			}
+			rule.Frequency = RecurrenceFrequency(index)
+
+		case "INTERVAL":
+			rule.Interval, err = parseRecurrenceInt(key, value, 1)
+		case "COUNT":
+			rule.Count, err = parseRecurrenceInt(key, value, 1)
+		case "UNTIL":
+			err = rule.parseUntil(value)
+		case "BYSECOND":
+			rule.BySecond, err = parseRecurrenceList(key, value, 0, 59, false)
+		case "BYMINUTE":
+			rule.ByMinute, err = parseRecurrenceList(key, value, 0, 59, false)
+		case "BYHOUR":
+			rule.ByHour, err = parseRecurrenceList(key, value, 0, 23, false)
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseRecurrenceList(key, value, 1, 31, true)
+		case "BYYEARDAY":
+			rule.ByYearDay, err = parseRecurrenceList(key, value, 1, 366, true)
+		case "BYWEEKNO":
+			rule.ByWeekNo, err = parseRecurrenceList(key, value, 1, 53, true)
+		case "BYMONTH":
+			rule.ByMonth, err = parseRecurrenceList(key, value, 1, 12, false)
+		case "BYSETPOS":
+			rule.BySetPos, err = parseRecurrenceList(key, value, 1, 366, true)
+
+		case "BYDAY":
+			for _, entry := range strings.Split(value, ",") {
+				match := recurrenceByDay.FindStringSubmatch(entry)
+				if match == nil {
+					return RecurrenceRule{}, fmt.Errorf("recurrence BYDAY entry such as MO or -1FR, got %q", entry)
+				}
+
+				weekday := RecurrenceWeekday{Weekday: recurrenceWeekdays[match[2]]}
+				if match[1] != "" {
+					weekday.N, _ = strconv.Atoi(match[1])
+					if weekday.N == 0 || weekday.N < -53 || weekday.N > 53 {
+						return RecurrenceRule{}, fmt.Errorf("recurrence BYDAY occurrence between 1 and 53, got %q", entry)
+					}
+				}
+
+				rule.ByDay = append(rule.ByDay, weekday)
+			}
+
+		case "WKST":
+			weekday, ok := recurrenceWeekdays[value]
+			if !ok {
+				return RecurrenceRule{}, fmt.Errorf("recurrence WKST weekday, got %q", value)
+			}
+			rule.WeekStart = weekday
+
+		default:
+			return RecurrenceRule{}, fmt.Errorf("known recurrence rule part, got %q", key)
+		}
+
+		if err != nil {
+			return RecurrenceRule{}, err
+		}
+	}
+
+	if !seen["FREQ"] {
+		return RecurrenceRule{}, fmt.Errorf("recurrence rule with FREQ")
+	}
+
+	if err := rule.validate(); err != nil {
+		return RecurrenceRule{}, err
+	}
+
+	return rule, nil
+}
+
+func parseRecurrenceInt(key string, value string, min int) (int, error) {
+	number, err := strconv.Atoi(value)
+	if err != nil || number < min {
+		return 0, fmt.Errorf("recurrence %s of at least %d, got %q", key, min, value)
+	}
+
+	return number, nil
+}
+
+// parseRecurrenceList parses a comma separated list of integers between min and max. If negative is true, values between -max and -min are accepted as well.
+func parseRecurrenceList(key string, value string, min int, max int, negative bool) ([]int, error) {
+	var numbers []int
+	for _, entry := range strings.Split(value, ",") {
+		number, err := strconv.Atoi(entry)
+
+		magnitude := number
+		if negative && number < 0 {
+			magnitude = -number
+		}
+
+		if err != nil || magnitude < min || magnitude > max {
+			return nil, fmt.Errorf("recurrence %s values between %d and %d, got %q", key, min, max, entry)
+		}
+
+		numbers = append(numbers, number)
+	}
+
+	slices.Sort(numbers)
+
+	return slices.Compact(numbers), nil
+}
+
+// parseUntil parses a date ("20240101"), a UTC date and time ("20240101T120000Z") or a floating date and time ("20240101T120000").
+func (rule *RecurrenceRule) parseUntil(value string) error {
+	layouts := []struct {
+		layout string
+		local  bool
+		date   bool
+	}{
+		{layout: "20060102T150405Z", local: false},
+		{layout: "20060102T150405", local: true},
+		{layout: "20060102", local: true, date: true},
+	}
+
+	for _, layout := range layouts {
+		if until, err := time.Parse(layout.layout, value); err == nil {
+			rule.Until = until
+			rule.untilLocal = layout.local
+			rule.untilDate = layout.date
+			return nil
+		}
+	}
+
+	return fmt.Errorf("recurrence UNTIL as date or date and time, got %q", value)
+}
+
+// validate rejects parts that are not allowed for the frequency and combinations that can never produce an occurrence.
+func (rule *RecurrenceRule) validate() error {
+	if rule.Count > 0 && !rule.Until.IsZero() {
+		return fmt.Errorf("recurrence rule with either COUNT or UNTIL")
+	}
+
+	if len(rule.ByWeekNo) > 0 && rule.Frequency != RecurrenceYearly {
+		return fmt.Errorf("recurrence BYWEEKNO only with FREQ=YEARLY")
+	}
+
+	if len(rule.ByYearDay) > 0 && (rule.Frequency == RecurrenceDaily || rule.Frequency == RecurrenceWeekly || rule.Frequency == RecurrenceMonthly) {
+		return fmt.Errorf("recurrence BYYEARDAY not with FREQ=%s", rule.Frequency)
+	}
+
+	if len(rule.ByMonthDay) > 0 && rule.Frequency == RecurrenceWeekly {
+		return fmt.Errorf("recurrence BYMONTHDAY not with FREQ=WEEKLY")
+	}
+
+	if len(rule.BySetPos) > 0 && len(rule.BySecond)+len(rule.ByMinute)+len(rule.ByHour)+len(rule.ByDay)+len(rule.ByMonthDay)+len(rule.ByYearDay)+len(rule.ByWeekNo)+len(rule.ByMonth) == 0 {
+		return fmt.Errorf("recurrence BYSETPOS with another BY part")
+	}
+
+	for _, weekday := range rule.ByDay {
+		if weekday.N == 0 {
+			continue
+		}
+
+		switch {
+		case rule.Frequency != RecurrenceMonthly && rule.Frequency != RecurrenceYearly:
+			return fmt.Errorf("recurrence BYDAY occurrences only with FREQ=MONTHLY or FREQ=YEARLY")
+		case len(rule.ByWeekNo) > 0:
+			return fmt.Errorf("recurrence BYDAY occurrences not with BYWEEKNO")
+		case rule.nthInMonth() && (weekday.N > 5 || weekday.N < -5):
+			return fmt.Errorf("recurrence BYDAY occurrence within a month between 1 and 5, got %d", weekday.N)
+		}
+	}
+
+	if len(rule.ByMonthDay) > 0 {
+		lengths := [13]int{0, 31, 29, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+
+		months := rule.ByMonth
+		if len(months) == 0 {
+			months = []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+		}
+
+		possible := false
+		for _, month := range months {
+			for _, day := range rule.ByMonthDay {
+				if day <= lengths[month] && -day <= lengths[month] {
+					possible = true
+				}
+			}
+		}
+
+		if !possible {
+			return fmt.Errorf("recurrence BYMONTHDAY that exists in its months")
+		}
+	}
+
+	return nil
+}
+
+// nthInMonth checks if BYDAY occurrences count within the month instead of the year.
+func (rule RecurrenceRule) nthInMonth() bool {
+	return rule.Frequency == RecurrenceMonthly || (rule.Frequency == RecurrenceYearly && len(rule.ByMonth) > 0)
+}
+
+// String returns the rule as it was parsed, without an "RRULE:" prefix.
+func (rule RecurrenceRule) String() string {
+	return rule.expression
+}
+
+// until resolves UNTIL in the location. The zero time is returned if the rule is not bounded by time.
+func (rule RecurrenceRule) until(location *time.Location) time.Time {
+	if rule.Until.IsZero() || !rule.untilLocal {
+		return rule.Until
+	}
+
+	until := time.Date(rule.Until.Year(), rule.Until.Month(), rule.Until.Day(), rule.Until.Hour(), rule.Until.Minute(), rule.Until.Second(), 0, location)
+	if rule.untilDate {
+		// A date includes all occurrences on that day.
+		until = until.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+
+	return until
+}
+
+// Between expands the rule starting at start (DTSTART) and returns the occurrences from (inclusive) until to (exclusive).
+// The rule is evaluated on the wall clock of the location, so a daily 09:00 occurrence stays at 09:00 across daylight saving transitions.
+// If location is nil, the location of start is used. COUNT is counted from start, including occurrences before from.
+func (rule RecurrenceRule) Between(start time.Time, from time.Time, to time.Time, location *time.Location) []time.Time {
+	if location == nil {
+		location = start.Location()
+	}
+
+	start = start.In(location)
+	until := rule.until(location)
+
+	var occurrences []time.Time
+
+	count := 0
+	for period := 0; ; period++ {
+		begin, days, clock := rule.period(start, period)
+
+		// No occurrence of this or any later period can be earlier than its wall clock start.
+		earliest := time.Date(begin.Year(), begin.Month(), begin.Day(), begin.Hour(), begin.Minute(), begin.Second(), 0, location)
+		if !earliest.Before(to) || (!until.IsZero() && earliest.After(until)) {
+			return occurrences
+		}
+
+		for _, candidate := range rule.candidates(start, days, clock, location) {
+			if candidate.Before(start) {
+				continue
+			}
+
+			if !until.IsZero() && candidate.After(until) {
+				return occurrences
+			}
+
+			count++
+			if rule.Count > 0 && count > rule.Count {
+				return occurrences
+			}
+
+			if !candidate.Before(to) {
+				return occurrences
+			}
+
+			if !candidate.Before(from) {
+				occurrences = append(occurrences, candidate)
+			}
+		}
+	}
+}
+
+// period returns the wall clock start, the candidate days and, for sub-daily frequencies, the wall clock time of the nth period after start.
+// Wall clock values are represented in UTC so that daylight saving transitions do not affect the arithmetic.
+func (rule RecurrenceRule) period(start time.Time, period int) (time.Time, []Date, time.Time) {
+	year, month, day := start.Date()
+	step := period * rule.Interval
+
+	switch rule.Frequency {
+	case RecurrenceYearly:
+		first, last := Date{Year: year + step, Month: time.January, Day: 1}, Date{Year: year + step, Month: time.December, Day: 31}
+		if len(rule.ByWeekNo) > 0 {
+			first, last = recurrenceWeekOne(year+step, rule.WeekStart), recurrenceWeekOne(year+step+1, rule.WeekStart).AddDays(-1)
+		}
+
+		return first.Time(time.UTC), recurrenceDays(first, last), time.Time{}
+
+	case RecurrenceMonthly:
+		first := Date{Year: year, Month: month, Day: 1}
+		first = DateOf(first.Time(time.UTC).AddDate(0, step, 0))
+
+		return first.Time(time.UTC), recurrenceDays(first, DateOf(first.Time(time.UTC).AddDate(0, 1, -1))), time.Time{}
+
+	case RecurrenceWeekly:
+		first := DateOf(start).AddDays(-recurrenceWeekdayOffset(start.Weekday(), rule.WeekStart) + 7*step)
+
+		return first.Time(time.UTC), recurrenceDays(first, first.AddDays(6)), time.Time{}
+
+	case RecurrenceDaily:
+		first := DateOf(start).AddDays(step)
+
+		return first.Time(time.UTC), []Date{first}, time.Time{}
+	}
+
+	clock := time.Date(year, month, day, start.Hour(), start.Minute(), start.Second(), 0, time.UTC)
+	switch rule.Frequency {
+	case RecurrenceHourly:
+		clock = time.Date(year, month, day, start.Hour()+step, 0, 0, 0, time.UTC)
+	case RecurrenceMinutely:
+		clock = time.Date(year, month, day, start.Hour(), start.Minute()+step, 0, 0, time.UTC)
+	case RecurrenceSecondly:
+		clock = clock.Add(time.Duration(step) * time.Second)
+	}
+
+	return clock, []Date{DateOf(clock)}, clock
+}
+
+// candidates returns the sorted occurrences of a period after applying the BY parts and BYSETPOS.
+func (rule RecurrenceRule) candidates(start time.Time, days []Date, clock time.Time, location *time.Location) []time.Time {
+	hours := rule.times(rule.ByHour, start.Hour(), clock.Hour(), rule.Frequency <= RecurrenceHourly)
+	minutes := rule.times(rule.ByMinute, start.Minute(), clock.Minute(), rule.Frequency <= RecurrenceMinutely)
+	seconds := rule.times(rule.BySecond, start.Second(), clock.Second(), rule.Frequency <= RecurrenceSecondly)
+
+	var candidates []time.Time
+	for _, day := range days {
+		if !rule.matchesDay(start, day) {
+			continue
+		}
+
+		for _, hour := range hours {
+			for _, minute := range minutes {
+				for _, second := range seconds {
+					candidates = append(candidates, time.Date(day.Year, day.Month, day.Day, hour, minute, second, 0, location))
+				}
+			}
+		}
+	}
+
+	slices.SortFunc(candidates, func(a, b time.Time) int { return a.Compare(b) })
+	candidates = slices.CompactFunc(candidates, func(a, b time.Time) bool { return a.Equal(b) })
+
+	if len(rule.BySetPos) == 0 {
+		return candidates
+	}
+
+	var selected []time.Time
+	for index, candidate := range candidates {
+		for _, position := range rule.BySetPos {
+			if position == index+1 || position == index-len(candidates) {
+				selected = append(selected, candidate)
+				break
+			}
+		}
+	}
+
+	return selected
+}
+
+// times returns the values of a time component. If the frequency is as fine as the component, the period's value is limited by the BY part.
+// Otherwise the BY part expands it, defaulting to the value of the start.
+func (rule RecurrenceRule) times(by []int, start int, period int, limit bool) []int {
+	if limit {
+		if len(by) > 0 && !slices.Contains(by, period) {
+			return nil
+		}
+
+		return []int{period}
+	}
+
+	if len(by) > 0 {
+		return by
+	}
+
+	return []int{start}
+}
+
+// matchesDay applies the day based BY parts and the defaults derived from the start when they are absent.
+func (rule RecurrenceRule) matchesDay(start time.Time, day Date) bool {
+	value := day.Time(time.UTC)
+
+	if len(rule.ByMonth) > 0 && !slices.Contains(rule.ByMonth, int(day.Month)) {
+		return false
+	}
+
+	if len(rule.ByWeekNo) > 0 {
+		week, weeks := recurrenceWeekNumber(day, rule.WeekStart)
+		if !slices.Contains(rule.ByWeekNo, week) && !slices.Contains(rule.ByWeekNo, week-weeks-1) {
+			return false
+		}
+	}
+
+	if len(rule.ByYearDay) > 0 {
+		yearDays := Date{Year: day.Year, Month: time.December, Day: 31}.Time(time.UTC).YearDay()
+		if !slices.Contains(rule.ByYearDay, value.YearDay()) && !slices.Contains(rule.ByYearDay, value.YearDay()-yearDays-1) {
+			return false
+		}
+	}
+
+	monthDays := Date{Year: day.Year, Month: day.Month + 1, Day: 0}.Time(time.UTC).Day()
+	if len(rule.ByMonthDay) > 0 && !slices.Contains(rule.ByMonthDay, day.Day) && !slices.Contains(rule.ByMonthDay, day.Day-monthDays-1) {
+		return false
+	}
+
+	if len(rule.ByDay) > 0 && !rule.matchesWeekday(day) {
+		return false
+	}
+
+	// Without the BY parts selecting days, the day is taken from the start.
+	switch rule.Frequency {
+	case RecurrenceYearly:
+		if len(rule.ByYearDay)+len(rule.ByMonthDay)+len(rule.ByDay) > 0 {
+			return true
+		}
+
+		switch {
+		case len(rule.ByWeekNo) > 0:
+			return value.Weekday() == start.Weekday()
+		case len(rule.ByMonth) > 0:
+			return day.Day == start.Day()
+		default:
+			return day.Month == start.Month() && day.Day == start.Day()
+		}
+
+	case RecurrenceMonthly:
+		if len(rule.ByMonthDay)+len(rule.ByDay) == 0 {
+			return day.Day == start.Day()
+		}
+
+	case RecurrenceWeekly:
+		if len(rule.ByDay) == 0 {
+			return value.Weekday() == start.Weekday()
+		}
+	}
+
+	return true
+}
+
+// matchesWeekday checks the BYDAY part. Occurrences are counted within the month or the year depending on the frequency.
+func (rule RecurrenceRule) matchesWeekday(day Date) bool {
+	value := day.Time(time.UTC)
+
+	for _, weekday := range rule.ByDay {
+		if value.Weekday() != weekday.Weekday {
+			continue
+		}
+
+		if weekday.N == 0 {
+			return true
+		}
+
+		index, length := value.YearDay(), Date{Year: day.Year, Month: time.December, Day: 31}.Time(time.UTC).YearDay()
+		if rule.nthInMonth() {
+			index, length = day.Day, Date{Year: day.Year, Month: day.Month + 1, Day: 0}.Time(time.UTC).Day()
+		}
+
+		if weekday.N == (index-1)/7+1 || weekday.N == -((length-index)/7+1) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// recurrenceWeekOne returns the first day of week 1 of a year, which is the week containing January 4th.
+func recurrenceWeekOne(year int, weekStart time.Weekday) Date {
+	january4 := Date{Year: year, Month: time.January, Day: 4}
+
+	return january4.AddDays(-recurrenceWeekdayOffset(january4.Time(time.UTC).Weekday(), weekStart))
+}
+
+// recurrenceWeekNumber returns the week number of a day and the number of weeks in its week numbering year.
+func recurrenceWeekNumber(day Date, weekStart time.Weekday) (int, int) {
+	first := day.AddDays(-recurrenceWeekdayOffset(day.Time(time.UTC).Weekday(), weekStart))
+	year := first.AddDays(3).Year
+
+	weekOne := recurrenceWeekOne(year, weekStart)
+	week := int(first.Time(time.UTC).Sub(weekOne.Time(time.UTC))/(7*24*time.Hour)) + 1
+	weeks := int(recurrenceWeekOne(year+1, weekStart).Time(time.UTC).Sub(weekOne.Time(time.UTC)) / (7 * 24 * time.Hour))
+
+	return week, weeks
+}
+
+// recurrenceWeekdayOffset returns the number of days since the start of the week.
+func recurrenceWeekdayOffset(weekday time.Weekday, weekStart time.Weekday) int {
+	return (int(weekday) - int(weekStart) + 7) % 7
+}
+
+// recurrenceDays returns all days from first to last inclusive.
+func recurrenceDays(first Date, last Date) []Date {
+	var days []Date
+	for day := first; !day.After(last); day = day.AddDays(1) {
+		days = append(days, day)
+	}
+
+	return days
+}
+
+// WrapperRecurrence wraps an RFC 5545 recurrence rule (RRULE) and can expand its occurrences.
+type WrapperRecurrence Wrapper[RecurrenceRule, string]
+
+var _ WrapperProvider = (*WrapperRecurrence)(nil) // Ensure that WrapperRecurrence implements WrapperProvider.
+
+func (wrapper *WrapperRecurrence) Get() RecurrenceRule {
+	return wrapper.Value
+}
+
+func (wrapper *WrapperRecurrence) GetAny() any {
+	return wrapper.Get()
+}
+
+// Between expands the rule starting at start and returns the occurrences from (inclusive) until to (exclusive) in the location. See RecurrenceRule.Between.
+// Discarded wrappers have no occurrences.
+func (wrapper *WrapperRecurrence) Between(start time.Time, from time.Time, to time.Time, location *time.Location) []time.Time {
+	if wrapper.IsDiscarded() {
+		return nil
+	}
+
+	return wrapper.Value.Between(start, from, to, location)
+}
+
+func (wrapper *WrapperRecurrence) Wrap(value any, discard bool) error {
+	switch v := value.(type) {
+	case nil:
+		wrapper.Discard()
+		if !discard {
+			return ErrorNil(WrapperRecurrenceName)
+		}
+
+	case WrapperProvider:
+		if v.IsDiscarded() {
+			wrapper.Discard()
+			return nil
+		}
+
+		return wrapper.Wrap(v.UnwrapAny(), discard)
+
+	case RecurrenceRule:
+		return wrapper.Wrap(v.String(), discard)
+
+	case string:
+		if strings.TrimSpace(v) == "" {
+			wrapper.Discard()
+			if !discard {
+				return ErrorNil(WrapperRecurrenceName)
+			}
+			return nil
+		}
+
+		rule, err := ParseRecurrenceRule(v)
+		if err != nil {
+			wrapper.Discard()
+			if !discard {
+				return ErrorParse(WrapperRecurrenceName, value, err)
+			}
+			return nil
+		}
+
+		wrapper.Value = rule
+
+	default:
+		wrapper.Discard()
+		if !discard {
+			return ErrorType(WrapperRecurrenceName, value)
+		}
+	}
+
+	return nil
+}
+
+func (wrapper *WrapperRecurrence) Unwrap() string {
+	if wrapper.IsDiscarded() {
+		return ""
+	}
+
+	return wrapper.Value.String()
+}
+
+func (wrapper *WrapperRecurrence) UnwrapAny() any {
+	return wrapper.Unwrap()
+}
+
+func (wrapper *WrapperRecurrence) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(wrapper)
+}
+
+func (wrapper *WrapperRecurrence) UnmarshalJSON(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalJSON(data, wrapper)
+}
+
+func (wrapper *WrapperRecurrence) MarshalText() ([]byte, error) {
+	return MarshalText(wrapper)
+}
+
+func (wrapper *WrapperRecurrence) UnmarshalText(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalText(data, wrapper)
+}
+
+func (wrapper *WrapperRecurrence) Scan(src any) error {
+	if wrapper == nil {
+		return fmt.Errorf("scan into nil wrapper")
+	}
+
+	return Scan(src, wrapper)
+}
+
+func (wrapper *WrapperRecurrence) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return MarshalXML(encoder, start, wrapper)
+}
+
+func (wrapper *WrapperRecurrence) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXML(decoder, start, wrapper)
+}
+
+func (wrapper *WrapperRecurrence) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return MarshalXMLAttr(name, wrapper)
+}
+
+func (wrapper *WrapperRecurrence) UnmarshalXMLAttr(attr xml.Attr) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXMLAttr(attr, wrapper)
+}
+
+func (wrapper *WrapperRecurrence) Set(value string) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return Set(value, wrapper)
+}
+
+func (wrapper *WrapperRecurrence) String() string {
+	return String(wrapper)
+}
+
+func (wrapper *WrapperRecurrence) Schema() Schema {
+	return Schema{Type: "string", Examples: SchemaExamples(WrapperRecurrenceExample, wrapper)}
+}
@@ -0,0 +1,279 @@
+package wrappers
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// TestWrapperRecurrence_Wrap tests the Wrap method of WrapperRecurrence.
+func TestWrapperRecurrence_Wrap(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       any
+		discard     bool
+		want        string
+		wantError   bool
+		wantDiscard bool
+	}{
+		{name: "Wrap weekly", input: "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10", want: "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10"},
+		{name: "Wrap prefix", input: "RRULE:FREQ=DAILY;INTERVAL=2", want: "FREQ=DAILY;INTERVAL=2"},
+		{name: "Wrap lowercase", input: "freq=monthly;byday=-1fr", want: "freq=monthly;byday=-1fr"},
+		{name: "Wrap until date", input: "FREQ=DAILY;UNTIL=20241231", want: "FREQ=DAILY;UNTIL=20241231"},
+		{name: "Wrap until UTC", input: "FREQ=DAILY;UNTIL=20241231T235959Z", want: "FREQ=DAILY;UNTIL=20241231T235959Z"},
+		{name: "Wrap leap day", input: "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=29", want: "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=29"},
+		{name: "Wrap missing frequency", input: "COUNT=10", wantError: true, wantDiscard: true},
+		{name: "Wrap unknown frequency", input: "FREQ=FORTNIGHTLY", wantError: true, wantDiscard: true},
+		{name: "Wrap unknown part", input: "FREQ=DAILY;EVERY=2", wantError: true, wantDiscard: true},
+		{name: "Wrap duplicate part", input: "FREQ=DAILY;FREQ=WEEKLY", wantError: true, wantDiscard: true},
+		{name: "Wrap malformed part", input: "FREQ=DAILY;COUNT", wantError: true, wantDiscard: true},
+		{name: "Wrap zero interval", input: "FREQ=DAILY;INTERVAL=0", wantError: true, wantDiscard: true},
+		{name: "Wrap count and until", input: "FREQ=DAILY;COUNT=5;UNTIL=20241231", wantError: true, wantDiscard: true},
+		{name: "Wrap invalid until", input: "FREQ=DAILY;UNTIL=2024-12-31", wantError: true, wantDiscard: true},
+		{name: "Wrap hour out of range", input: "FREQ=DAILY;BYHOUR=24", wantError: true, wantDiscard: true},
+		{name: "Wrap month day zero", input: "FREQ=MONTHLY;BYMONTHDAY=0", wantError: true, wantDiscard: true},
+		{name: "Wrap invalid weekday", input: "FREQ=WEEKLY;BYDAY=MON", wantError: true, wantDiscard: true},
+		{name: "Wrap weekday occurrence with weekly", input: "FREQ=WEEKLY;BYDAY=1MO", wantError: true, wantDiscard: true},
+		{name: "Wrap weekday occurrence beyond month", input: "FREQ=MONTHLY;BYDAY=6MO", wantError: true, wantDiscard: true},
+		{name: "Wrap weekday occurrence with week number", input: "FREQ=YEARLY;BYWEEKNO=20;BYDAY=1MO", wantError: true, wantDiscard: true},
+		{name: "Wrap week number with monthly", input: "FREQ=MONTHLY;BYWEEKNO=20", wantError: true, wantDiscard: true},
+		{name: "Wrap year day with monthly", input: "FREQ=MONTHLY;BYYEARDAY=100", wantError: true, wantDiscard: true},
+		{name: "Wrap month day with weekly", input: "FREQ=WEEKLY;BYMONTHDAY=1", wantError: true, wantDiscard: true},
+		{name: "Wrap set position alone", input: "FREQ=MONTHLY;BYSETPOS=1", wantError: true, wantDiscard: true},
+		{name: "Wrap impossible month day", input: "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30", wantError: true, wantDiscard: true},
+		{name: "Wrap impossible month day with discard", input: "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30", discard: true, wantDiscard: true},
+		{name: "Wrap empty", input: "", wantError: true, wantDiscard: true},
+		{name: "Wrap unsupported type", input: 7, wantError: true, wantDiscard: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapper := New[*WrapperRecurrence]()
+
+			err := wrapper.Wrap(tt.input, tt.discard)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("Wrap() error = %v, wantError %v", err, tt.wantError)
+			}
+
+			if wrapper.IsDiscarded() != tt.wantDiscard {
+				t.Errorf("IsDiscarded() = %v, want %v", wrapper.IsDiscarded(), tt.wantDiscard)
+			}
+
+			if !tt.wantDiscard && wrapper.Unwrap() != tt.want {
+				t.Errorf("Unwrap() = %v, want %v", wrapper.Unwrap(), tt.want)
+			}
+		})
+	}
+}
+
+// TestWrapperRecurrence_Between tests the expansion of occurrences of WrapperRecurrence, mostly with examples from RFC 5545.
+func TestWrapperRecurrence_Between(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Tuesday, September 2nd 1997 09:00 in New York as used throughout RFC 5545.
+	start := time.Date(1997, time.September, 2, 9, 0, 0, 0, newYork)
+	farFuture := time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 9, 0, 0, 0, newYork)
+	}
+
+	tests := []struct {
+		name  string
+		rule  string
+		start time.Time
+		from  time.Time
+		to    time.Time
+		want  []time.Time
+	}{
+		{
+			name:  "Daily for 3 occurrences across daylight saving",
+			rule:  "FREQ=DAILY;COUNT=3",
+			start: date(1997, time.October, 25),
+			to:    farFuture,
+			want:  []time.Time{date(1997, time.October, 25), date(1997, time.October, 26), date(1997, time.October, 27)},
+		},
+		{
+			name: "Every other day until a date",
+			rule: "FREQ=DAILY;INTERVAL=2;UNTIL=19970908",
+			to:   farFuture,
+			want: []time.Time{date(1997, time.September, 2), date(1997, time.September, 4), date(1997, time.September, 6), date(1997, time.September, 8)},
+		},
+		{
+			name: "Weekly on Tuesday and Thursday for 4 occurrences",
+			rule: "FREQ=WEEKLY;COUNT=4;WKST=SU;BYDAY=TU,TH",
+			to:   farFuture,
+			want: []time.Time{date(1997, time.September, 2), date(1997, time.September, 4), date(1997, time.September, 9), date(1997, time.September, 11)},
+		},
+		{
+			name: "Monthly on the first Friday",
+			rule: "FREQ=MONTHLY;COUNT=3;BYDAY=1FR",
+			to:   farFuture,
+			want: []time.Time{date(1997, time.September, 5), date(1997, time.October, 3), date(1997, time.November, 7)},
+		},
+		{
+			name: "Monthly on the second to last Monday",
+			rule: "FREQ=MONTHLY;COUNT=3;BYDAY=-2MO",
+			to:   farFuture,
+			want: []time.Time{date(1997, time.September, 22), date(1997, time.October, 20), date(1997, time.November, 17)},
+		},
+		{
+			name: "Monthly on the third to last day",
+			rule: "FREQ=MONTHLY;BYMONTHDAY=-3",
+			to:   date(1997, time.November, 1),
+			want: []time.Time{date(1997, time.September, 28), date(1997, time.October, 29)},
+		},
+		{
+			name: "Friday the 13th",
+			rule: "FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13",
+			to:   date(1998, time.December, 1),
+			want: []time.Time{date(1998, time.February, 13), date(1998, time.March, 13), date(1998, time.November, 13)},
+		},
+		{
+			name: "Last work day of the month",
+			rule: "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1",
+			to:   date(1997, time.December, 1),
+			want: []time.Time{date(1997, time.September, 30), date(1997, time.October, 31), date(1997, time.November, 28)},
+		},
+		{
+			name:  "Monday of week number 20",
+			rule:  "FREQ=YEARLY;BYWEEKNO=20;BYDAY=MO",
+			start: date(1997, time.May, 12),
+			to:    date(2000, time.January, 1),
+			want:  []time.Time{date(1997, time.May, 12), date(1998, time.May, 11), date(1999, time.May, 17)},
+		},
+		{
+			name:  "Every 100th day",
+			rule:  "FREQ=YEARLY;INTERVAL=3;COUNT=3;BYYEARDAY=1,100,200",
+			start: date(1997, time.January, 1),
+			to:    farFuture,
+			want:  []time.Time{date(1997, time.January, 1), date(1997, time.April, 10), date(1997, time.July, 19)},
+		},
+		{
+			name:  "US presidential election day",
+			rule:  "FREQ=YEARLY;INTERVAL=4;BYMONTH=11;BYDAY=TU;BYMONTHDAY=2,3,4,5,6,7,8",
+			start: date(1996, time.November, 5),
+			to:    date(2005, time.January, 1),
+			want:  []time.Time{date(1996, time.November, 5), date(2000, time.November, 7), date(2004, time.November, 2)},
+		},
+		{
+			name:  "Leap day",
+			rule:  "FREQ=YEARLY;COUNT=2",
+			start: date(2024, time.February, 29),
+			to:    farFuture,
+			want:  []time.Time{date(2024, time.February, 29), date(2028, time.February, 29)},
+		},
+		{
+			name: "Hourly with hour limit",
+			rule: "FREQ=HOURLY;INTERVAL=3;BYHOUR=9,12,15",
+			to:   date(1997, time.September, 3),
+			want: []time.Time{date(1997, time.September, 2), date(1997, time.September, 2).Add(3 * time.Hour), date(1997, time.September, 2).Add(6 * time.Hour)},
+		},
+		{
+			name: "Daily with hours and minutes",
+			rule: "FREQ=DAILY;BYHOUR=9,10;BYMINUTE=0,30;COUNT=4",
+			to:   farFuture,
+			want: []time.Time{date(1997, time.September, 2), date(1997, time.September, 2).Add(30 * time.Minute), date(1997, time.September, 2).Add(time.Hour), date(1997, time.September, 2).Add(90 * time.Minute)},
+		},
+		{
+			name: "Window excludes earlier occurrences but counts them",
+			rule: "FREQ=WEEKLY;COUNT=3",
+			from: date(1997, time.September, 3),
+			to:   farFuture,
+			want: []time.Time{date(1997, time.September, 9), date(1997, time.September, 16)},
+		},
+		{
+			name: "Unbounded rule within window",
+			rule: "FREQ=WEEKLY;BYDAY=SA",
+			from: date(1998, time.January, 1),
+			to:   date(1998, time.January, 15),
+			want: []time.Time{date(1998, time.January, 3), date(1998, time.January, 10)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapper := New[*WrapperRecurrence]()
+			if err := wrapper.Wrap(tt.rule, false); err != nil {
+				t.Fatalf("Wrap() error = %v", err)
+			}
+
+			ruleStart := tt.start
+			if ruleStart.IsZero() {
+				ruleStart = start
+			}
+
+			got := wrapper.Between(ruleStart, tt.from, tt.to, newYork)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Between() = %v, want %v", got, tt.want)
+			}
+
+			for i := range got {
+				if !got[i].Equal(tt.want[i]) {
+					t.Errorf("Between()[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+// TestWrapperRecurrence_Location tests that floating rules are expanded on the wall clock of the given location.
+func TestWrapperRecurrence_Location(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wrapper := New[*WrapperRecurrence]()
+	if err := wrapper.Wrap("FREQ=DAILY;UNTIL=20240401T090000", false); err != nil {
+		t.Fatalf("Wrap() error = %v", err)
+	}
+
+	// The start is given in UTC and converted to Berlin, where daylight saving begins on March 31st.
+	start := time.Date(2024, time.March, 30, 8, 0, 0, 0, time.UTC)
+	got := wrapper.Between(start, start, start.AddDate(1, 0, 0), berlin)
+
+	want := []time.Time{
+		time.Date(2024, time.March, 30, 9, 0, 0, 0, berlin),
+		time.Date(2024, time.March, 31, 9, 0, 0, 0, berlin),
+		time.Date(2024, time.April, 1, 9, 0, 0, 0, berlin),
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("Between() = %v, want %v", got, want)
+	}
+
+	for i := range got {
+		if !got[i].Equal(want[i]) {
+			t.Errorf("Between()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+// TestWrapperRecurrence_JSON tests JSON marshalling and unmarshalling of WrapperRecurrence within a struct.
+func TestWrapperRecurrence_JSON(t *testing.T) {
+	type Event struct {
+		Recurrence *WrapperRecurrence `json:"recurrence"`
+	}
+
+	var event Event
+	if err := json.Unmarshal([]byte(`{"recurrence":"RRULE:FREQ=WEEKLY;BYDAY=MO"}`), &event); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	if string(data) != `{"recurrence":"FREQ=WEEKLY;BYDAY=MO"}` {
+		t.Errorf("Marshalled JSON = %v", string(data))
+	}
+
+	if err := json.Unmarshal([]byte(`{"recurrence":"FREQ=WEEKLY;BYDAY=1MO"}`), &event); err == nil {
+		t.Errorf("Unmarshal() expected error for contradicting rule")
+	}
+}
@@ -1,11 +1,13 @@
 package wrappers
 
 import (
+	"encoding/xml"
 	"fmt"
 )
 
 const (
-	WrapperStringName Name = "WrapperString"
+	WrapperStringName    Name   = "WrapperString"
+	WrapperStringExample string = "hello"
 )
 
 type WrapperString Wrapper[string, string]
@@ -82,3 +84,63 @@ func (wrapper *WrapperString) UnmarshalJSON(data []byte) error {
 
 	return UnmarshalJSON(data, wrapper)
 }
+
+func (wrapper *WrapperString) MarshalText() ([]byte, error) {
+	return MarshalText(wrapper)
+}
+
+func (wrapper *WrapperString) UnmarshalText(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalText(data, wrapper)
+}
+
+func (wrapper *WrapperString) Scan(src any) error {
+	if wrapper == nil {
+		return fmt.Errorf("scan into nil wrapper")
+	}
+
+	return Scan(src, wrapper)
+}
+
+func (wrapper *WrapperString) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return MarshalXML(encoder, start, wrapper)
+}
+
+func (wrapper *WrapperString) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXML(decoder, start, wrapper)
+}
+
+func (wrapper *WrapperString) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return MarshalXMLAttr(name, wrapper)
+}
+
+func (wrapper *WrapperString) UnmarshalXMLAttr(attr xml.Attr) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXMLAttr(attr, wrapper)
+}
+
+func (wrapper *WrapperString) Set(value string) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return Set(value, wrapper)
+}
+
+func (wrapper *WrapperString) String() string {
+	return String(wrapper)
+}
+
+func (wrapper *WrapperString) Schema() Schema {
+	return Schema{Type: "string", Examples: SchemaExamples(WrapperStringExample, wrapper)}
+}
@@ -1,19 +1,49 @@
 package wrappers
 
 import (
+	"encoding/xml"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 )
 
 const (
-	WrapperTimeISO8601Name Name = "WrapperTimeISO8601"
+	WrapperTimeISO8601Name    Name   = "WrapperTimeISO8601"
+	WrapperTimeISO8601Example string = "2024-01-01T12:00:00+02:00, 20240101T120000Z, 2024-W01-1, 2024-001, 2024-01-01"
 )
 
-type WrapperTimeISO8601 Wrapper[time.Time, string]
+var (
+	iso8601DateExtended = regexp.MustCompile(`^(\d{4})(?:-(\d{2})(?:-(\d{2}))?|-W(\d{2})(?:-(\d))?|-(\d{3}))?$`)
+	iso8601DateBasic    = regexp.MustCompile(`^(\d{4})(?:(\d{2})(\d{2})|W(\d{2})(\d)?|(\d{3}))$`)
+	iso8601TimeExtended = regexp.MustCompile(`^(\d{2})(?::(\d{2})(?::(\d{2}))?)?(?:[.,](\d+))?$`)
+	iso8601TimeBasic    = regexp.MustCompile(`^(\d{2})(?:(\d{2})(\d{2})?)?(?:[.,](\d+))?$`)
+	iso8601Offset       = regexp.MustCompile(`(?:Z|z|[+-](\d{2})(?::?(\d{2}))?)$`)
+)
+
+// WrapperTimeISO8601 parses ISO 8601 date and time representations in extended ("2024-01-01T12:00:00+02:00") and basic ("20240101T120000Z") format.
+// Week dates ("2024-W01-1"), ordinal dates ("2024-001"), reduced precision ("2024-01", "2024-01-01T12"), date-only values and fractional components are supported.
+// Values without an offset are interpreted as UTC.
+type WrapperTimeISO8601 struct {
+	Wrapper[time.Time, string]
+	TimeConstraints
+	normalizeUTC       bool // If true, the value is converted to UTC when unwrapping. Otherwise the original offset is preserved.
+	includeNanoseconds bool // If true, fractional seconds are included when unwrapping.
+}
 
 var _ WrapperProvider = (*WrapperTimeISO8601)(nil) // Ensure that WrapperTimeISO8601 implements WrapperProvider.
 
+// SetNormalizeUTC configures whether the value is unwrapped in UTC instead of its original offset.
+func (wrapper *WrapperTimeISO8601) SetNormalizeUTC(normalize bool) {
+	wrapper.normalizeUTC = normalize
+}
+
+// SetIncludeNanoseconds configures whether fractional seconds are included when unwrapping.
+func (wrapper *WrapperTimeISO8601) SetIncludeNanoseconds(include bool) {
+	wrapper.includeNanoseconds = include
+}
+
 func (wrapper *WrapperTimeISO8601) Get() time.Time {
 	return wrapper.Value
 }
@@ -36,7 +66,7 @@ func (wrapper *WrapperTimeISO8601) Wrap(value any, discard bool) error {
 			return nil
 		}
 
-		wrapper.Value = v.Get()
+		return wrapper.set(v.Get(), value, discard)
 
 	case WrapperProvider:
 		if v.IsDiscarded() {
@@ -47,7 +77,7 @@ func (wrapper *WrapperTimeISO8601) Wrap(value any, discard bool) error {
 		return wrapper.Wrap(v.UnwrapAny(), discard)
 
 	case time.Time:
-		wrapper.Value = v
+		return wrapper.set(v, value, discard)
 
 	case string:
 		if v == "" {
@@ -55,12 +85,7 @@ func (wrapper *WrapperTimeISO8601) Wrap(value any, discard bool) error {
 			return nil
 		}
 
-		v = strings.TrimSuffix(v, "+0000")
-		if !strings.HasSuffix(v, "Z") {
-			v += "Z"
-		}
-
-		parsed, err := time.Parse(time.RFC3339, v)
+		parsed, err := ParseISO8601(v)
 		if err != nil {
 			wrapper.Discard()
 			if !discard {
@@ -70,7 +95,7 @@ func (wrapper *WrapperTimeISO8601) Wrap(value any, discard bool) error {
 			}
 		}
 
-		wrapper.Value = parsed
+		return wrapper.set(parsed, value, discard)
 
 	default:
 		wrapper.Discard()
@@ -82,12 +107,37 @@ func (wrapper *WrapperTimeISO8601) Wrap(value any, discard bool) error {
 	return nil
 }
 
+// set validates the time against the configured constraints before storing it.
+func (wrapper *WrapperTimeISO8601) set(parsed time.Time, value any, discard bool) error {
+	if err := wrapper.checkTime(parsed); err != nil {
+		wrapper.Discard()
+		if !discard {
+			return ErrorValue(WrapperTimeISO8601Name, value, err.Error())
+		}
+		return nil
+	}
+
+	wrapper.Value = parsed
+
+	return nil
+}
+
 func (wrapper *WrapperTimeISO8601) Unwrap() string {
+	layout := time.RFC3339
+	if wrapper.includeNanoseconds {
+		layout = time.RFC3339Nano
+	}
+
 	if wrapper.IsDiscarded() {
-		return new(time.Time).Format(time.RFC3339)
+		return new(time.Time).Format(layout)
 	}
 
-	return wrapper.Value.Format(time.RFC3339)
+	value := wrapper.Value
+	if wrapper.normalizeUTC {
+		value = value.UTC()
+	}
+
+	return value.Format(layout)
 }
 
 func (wrapper *WrapperTimeISO8601) UnwrapAny() any {
@@ -105,3 +155,227 @@ func (wrapper *WrapperTimeISO8601) UnmarshalJSON(data []byte) error {
 
 	return UnmarshalJSON(data, wrapper)
 }
+
+func (wrapper *WrapperTimeISO8601) MarshalText() ([]byte, error) {
+	return MarshalText(wrapper)
+}
+
+func (wrapper *WrapperTimeISO8601) UnmarshalText(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalText(data, wrapper)
+}
+
+func (wrapper *WrapperTimeISO8601) Scan(src any) error {
+	if wrapper == nil {
+		return fmt.Errorf("scan into nil wrapper")
+	}
+
+	return Scan(src, wrapper)
+}
+
+func (wrapper *WrapperTimeISO8601) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return MarshalXML(encoder, start, wrapper)
+}
+
+func (wrapper *WrapperTimeISO8601) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXML(decoder, start, wrapper)
+}
+
+func (wrapper *WrapperTimeISO8601) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return MarshalXMLAttr(name, wrapper)
+}
+
+func (wrapper *WrapperTimeISO8601) UnmarshalXMLAttr(attr xml.Attr) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXMLAttr(attr, wrapper)
+}
+
+func (wrapper *WrapperTimeISO8601) Set(value string) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return Set(value, wrapper)
+}
+
+func (wrapper *WrapperTimeISO8601) String() string {
+	return String(wrapper)
+}
+
+func (wrapper *WrapperTimeISO8601) Schema() Schema {
+	return Schema{Type: "string", Format: "date-time", Examples: SchemaExamples(WrapperTimeISO8601Example, wrapper)}
+}
+
+// ParseISO8601 parses an ISO 8601 date or date and time representation. Values without an offset are interpreted as UTC.
+func ParseISO8601(value string) (time.Time, error) {
+	datePart, timePart, hasTime := strings.Cut(value, "T")
+	if !hasTime {
+		datePart, timePart, hasTime = strings.Cut(value, "t")
+	}
+
+	date, err := parseISO8601Date(datePart)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if !hasTime {
+		return date, nil
+	}
+
+	location := time.UTC
+	if match := iso8601Offset.FindStringSubmatchIndex(timePart); match != nil {
+		offset := timePart[match[0]:]
+		timePart = timePart[:match[0]]
+
+		if offset != "Z" && offset != "z" {
+			hours, _ := strconv.Atoi(offset[match[2]-match[0] : match[3]-match[0]])
+			minutes := 0
+			if match[4] >= 0 {
+				minutes, _ = strconv.Atoi(offset[match[4]-match[0] : match[5]-match[0]])
+			}
+
+			if hours > 23 || minutes > 59 {
+				return time.Time{}, fmt.Errorf("ISO 8601 offset, got %s", offset)
+			}
+
+			seconds := hours*3600 + minutes*60
+			if offset[0] == '-' {
+				seconds = -seconds
+			}
+
+			location = time.FixedZone("", seconds)
+		}
+	}
+
+	clock, err := parseISO8601Time(timePart)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	year, month, day := date.Date()
+
+	return time.Date(year, month, day, 0, 0, 0, 0, location).Add(clock), nil
+}
+
+// parseISO8601Date parses calendar, week and ordinal dates in extended or basic format, including reduced precision calendar dates.
+func parseISO8601Date(value string) (time.Time, error) {
+	match := iso8601DateExtended.FindStringSubmatch(value)
+	if match == nil {
+		match = iso8601DateBasic.FindStringSubmatch(value)
+	}
+	if match == nil {
+		return time.Time{}, fmt.Errorf("ISO 8601 date, got %q", value)
+	}
+
+	year, _ := strconv.Atoi(match[1])
+
+	switch {
+	case match[4] != "": // Week date.
+		week, _ := strconv.Atoi(match[4])
+		weekday := 1
+		if match[5] != "" {
+			weekday, _ = strconv.Atoi(match[5])
+		}
+
+		if weekday < 1 || weekday > 7 {
+			return time.Time{}, fmt.Errorf("ISO 8601 weekday between 1 and 7, got %d", weekday)
+		}
+
+		// Week 1 is the week containing January 4th. Weeks start on Monday.
+		january4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+		monday := january4.AddDate(0, 0, -((int(january4.Weekday()) + 6) % 7))
+		date := monday.AddDate(0, 0, (week-1)*7+weekday-1)
+
+		if isoYear, isoWeek := date.ISOWeek(); week < 1 || isoYear != year || isoWeek != week {
+			return time.Time{}, fmt.Errorf("ISO 8601 week of %d, got %d", year, week)
+		}
+
+		return date, nil
+
+	case match[6] != "": // Ordinal date.
+		ordinal, _ := strconv.Atoi(match[6])
+
+		date := time.Date(year, time.January, ordinal, 0, 0, 0, 0, time.UTC)
+		if ordinal < 1 || date.Year() != year {
+			return time.Time{}, fmt.Errorf("ISO 8601 ordinal day of %d, got %d", year, ordinal)
+		}
+
+		return date, nil
+
+	default: // Calendar date.
+		month, day := 1, 1
+		if match[2] != "" {
+			month, _ = strconv.Atoi(match[2])
+		}
+		if match[3] != "" {
+			day, _ = strconv.Atoi(match[3])
+		}
+
+		date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+		if month < 1 || month > 12 || day < 1 || date.Day() != day {
+			return time.Time{}, fmt.Errorf("ISO 8601 calendar date, got %q", value)
+		}
+
+		return date, nil
+	}
+}
+
+// parseISO8601Time parses a time of day in extended or basic format and returns it as the duration since midnight.
+// A decimal fraction applies to the lowest order component given. "24:00" denotes the end of the day.
+func parseISO8601Time(value string) (time.Duration, error) {
+	match := iso8601TimeExtended.FindStringSubmatch(value)
+	if match == nil {
+		match = iso8601TimeBasic.FindStringSubmatch(value)
+	}
+	if match == nil {
+		return 0, fmt.Errorf("ISO 8601 time, got %q", value)
+	}
+
+	hours, _ := strconv.Atoi(match[1])
+	minutes, seconds := 0, 0
+	if match[2] != "" {
+		minutes, _ = strconv.Atoi(match[2])
+	}
+	if match[3] != "" {
+		seconds, _ = strconv.Atoi(match[3])
+	}
+
+	if hours > 24 || minutes > 59 || seconds > 59 {
+		return 0, fmt.Errorf("ISO 8601 time, got %q", value)
+	}
+
+	var fraction time.Duration
+	if digits := match[4]; digits != "" {
+		switch {
+		case match[3] != "":
+			// Fractional seconds are truncated to nanosecond precision.
+			digits = (digits + "000000000")[:9]
+			nanoseconds, _ := strconv.Atoi(digits)
+			fraction = time.Duration(nanoseconds)
+
+		case match[2] != "":
+			decimal, _ := strconv.ParseFloat("0."+digits, 64)
+			fraction = time.Duration(decimal * float64(time.Minute))
+
+		default:
+			decimal, _ := strconv.ParseFloat("0."+digits, 64)
+			fraction = time.Duration(decimal * float64(time.Hour))
+		}
+	}
+
+	if hours == 24 && (minutes != 0 || seconds != 0 || fraction != 0) {
+		return 0, fmt.Errorf("ISO 8601 time, got %q", value)
+	}
+
+	return time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second + fraction, nil
+}
@@ -244,3 +244,111 @@ func TestWrapperTimeISO8601_JSONUnmarshal(t *testing.T) {
 		})
 	}
 }
+
+// TestParseISO8601 tests the ISO 8601 representations supported by ParseISO8601.
+func TestParseISO8601(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		want      string
+		wantError bool
+	}{
+		{name: "Extended with Z", input: "2024-01-01T12:00:00Z", want: "2024-01-01T12:00:00Z"},
+		{name: "Extended with offset", input: "2024-01-01T12:00:00+02:00", want: "2024-01-01T12:00:00+02:00"},
+		{name: "Extended with hour offset", input: "2024-01-01T12:00:00-05", want: "2024-01-01T12:00:00-05:00"},
+		{name: "Extended with basic offset", input: "2021-09-01T00:00:00+0000", want: "2021-09-01T00:00:00Z"},
+		{name: "Basic", input: "20240101T120000Z", want: "2024-01-01T12:00:00Z"},
+		{name: "Basic with offset", input: "20240101T120000+0130", want: "2024-01-01T12:00:00+01:30"},
+		{name: "Fractional seconds", input: "2024-01-01T12:00:00.123456789Z", want: "2024-01-01T12:00:00.123456789Z"},
+		{name: "Fractional seconds with comma", input: "2024-01-01T12:00:00,5Z", want: "2024-01-01T12:00:00.5Z"},
+		{name: "Fractional minutes", input: "2024-01-01T12:30.5Z", want: "2024-01-01T12:30:30Z"},
+		{name: "Fractional hours", input: "2024-01-01T12.25Z", want: "2024-01-01T12:15:00Z"},
+		{name: "Reduced precision time", input: "2024-01-01T12", want: "2024-01-01T12:00:00Z"},
+		{name: "End of day", input: "2024-01-01T24:00:00Z", want: "2024-01-02T00:00:00Z"},
+		{name: "Date only", input: "2024-02-29", want: "2024-02-29T00:00:00Z"},
+		{name: "Date only basic", input: "20240229", want: "2024-02-29T00:00:00Z"},
+		{name: "Reduced precision month", input: "2024-03", want: "2024-03-01T00:00:00Z"},
+		{name: "Reduced precision year", input: "2024", want: "2024-01-01T00:00:00Z"},
+		{name: "Week date", input: "2024-W01-1", want: "2024-01-01T00:00:00Z"},
+		{name: "Week date crossing years", input: "2020-W53-7", want: "2021-01-03T00:00:00Z"},
+		{name: "Week date basic", input: "2024W017T08:00Z", want: "2024-01-07T08:00:00Z"},
+		{name: "Week without day", input: "2024-W10", want: "2024-03-04T00:00:00Z"},
+		{name: "Ordinal date", input: "2024-060", want: "2024-02-29T00:00:00Z"},
+		{name: "Ordinal date basic", input: "2023365T23:59:59Z", want: "2023-12-31T23:59:59Z"},
+		{name: "Invalid leap day", input: "2023-02-29", wantError: true},
+		{name: "Invalid month", input: "2024-13-01", wantError: true},
+		{name: "Invalid week", input: "2024-W53-1", wantError: true},
+		{name: "Invalid ordinal", input: "2023-366", wantError: true},
+		{name: "Invalid time", input: "2024-01-01T25:00:00Z", wantError: true},
+		{name: "Invalid end of day", input: "2024-01-01T24:30:00Z", wantError: true},
+		{name: "Invalid offset", input: "2024-01-01T12:00:00+25:00", wantError: true},
+		{name: "Invalid format", input: "01/02/2024", wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := ParseISO8601(tt.input)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("ParseISO8601() error = %v, wantError %v", err, tt.wantError)
+			}
+
+			if err == nil && parsed.Format(time.RFC3339Nano) != tt.want {
+				t.Errorf("ParseISO8601() = %v, want %v", parsed.Format(time.RFC3339Nano), tt.want)
+			}
+		})
+	}
+}
+
+// TestWrapperTimeISO8601_Output tests the output options of WrapperTimeISO8601.
+func TestWrapperTimeISO8601_Output(t *testing.T) {
+	tests := []struct {
+		name               string
+		input              string
+		normalizeUTC       bool
+		includeNanoseconds bool
+		want               string
+	}{
+		{
+			name:  "Preserve offset",
+			input: "2024-01-01T12:00:00.5+02:00",
+			want:  "2024-01-01T12:00:00+02:00",
+		},
+		{
+			name:         "Normalize to UTC",
+			input:        "2024-01-01T12:00:00.5+02:00",
+			normalizeUTC: true,
+			want:         "2024-01-01T10:00:00Z",
+		},
+		{
+			name:               "Include nanoseconds",
+			input:              "2024-01-01T12:00:00.5+02:00",
+			includeNanoseconds: true,
+			want:               "2024-01-01T12:00:00.5+02:00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapper := New[*WrapperTimeISO8601]()
+			wrapper.SetNormalizeUTC(tt.normalizeUTC)
+			wrapper.SetIncludeNanoseconds(tt.includeNanoseconds)
+
+			if err := wrapper.Wrap(tt.input, false); err != nil {
+				t.Fatalf("Wrap() error = %v", err)
+			}
+
+			if wrapper.Unwrap() != tt.want {
+				t.Errorf("Unwrap() = %v, want %v", wrapper.Unwrap(), tt.want)
+			}
+
+			data, err := json.Marshal(wrapper)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+
+			if string(data) != `"`+tt.want+`"` {
+				t.Errorf("Marshalled JSON = %v, want %v", string(data), tt.want)
+			}
+		})
+	}
+}
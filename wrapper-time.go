@@ -1,18 +1,80 @@
 package wrappers
 
 import (
+	"encoding/xml"
 	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 )
 
 const (
-	WrapperTimeName Name = "WrapperTime"
+	WrapperTimeName    Name   = "WrapperTime"
+	WrapperTimeExample string = "2024-01-01T12:00:00Z, 2024-01-01T12:00:00+02:00"
 )
 
-type WrapperTime Wrapper[time.Time, string]
+// EpochUnit defines how numeric values are interpreted as Unix epoch timestamps.
+type EpochUnit int
+
+const (
+	EpochNone         EpochUnit = iota // Numeric values are rejected.
+	EpochAuto                          // The unit is detected by the magnitude of the value.
+	EpochSeconds                       // Seconds since the Unix epoch.
+	EpochMilliseconds                  // Milliseconds since the Unix epoch.
+	EpochMicroseconds                  // Microseconds since the Unix epoch.
+	EpochNanoseconds                   // Nanoseconds since the Unix epoch.
+)
+
+// TimeFormat defines how a wrapped time is unwrapped and marshalled.
+type TimeFormat int
+
+const (
+	TimeFormatRFC3339     TimeFormat = iota // RFC 3339 string without fractional seconds.
+	TimeFormatRFC3339Nano                   // RFC 3339 string with fractional seconds.
+	TimeFormatEpoch                         // Unix epoch number in the configured epoch unit (seconds if none or auto).
+)
+
+// WrapperTime wraps a time.Time. By default only RFC 3339 strings are accepted.
+type WrapperTime struct {
+	Wrapper[time.Time, string]
+	TimeConstraints
+	layouts   []string       // Layouts tried in order when parsing strings. Defaults to RFC 3339.
+	epochUnit EpochUnit      // Unit used for numeric values and numeric strings. Defaults to EpochNone.
+	format    TimeFormat     // Format used when unwrapping and marshalling. Defaults to TimeFormatRFC3339.
+	location  *time.Location // If set, wrapped times are converted into this location so identical instants compare and marshal identically.
+	relative  bool           // If true, human relative expressions such as "3 days ago" are resolved against the clock.
+}
 
 var _ WrapperProvider = (*WrapperTime)(nil) // Ensure that WrapperTime implements WrapperProvider.
 
+// SetLayouts sets the layouts (see time.Parse) that are tried in order when parsing strings.
+func (wrapper *WrapperTime) SetLayouts(layouts []string) {
+	wrapper.layouts = layouts
+}
+
+// SetEpochUnit enables numeric input and configures how numbers are interpreted as Unix epoch timestamps.
+func (wrapper *WrapperTime) SetEpochUnit(unit EpochUnit) {
+	wrapper.epochUnit = unit
+}
+
+// SetLocation configures the location wrapped times are normalized into, e.g. time.UTC. Passing nil keeps the location produced by parsing.
+func (wrapper *WrapperTime) SetLocation(location *time.Location) {
+	wrapper.location = location
+}
+
+// SetRelative enables human relative input such as "now", "tomorrow 9am", "in 2 hours", "3 days ago" or "+2h".
+// Expressions are resolved against the configured clock in the configured location, or the clock's location if none is set, and stored as absolute times.
+func (wrapper *WrapperTime) SetRelative(relative bool) {
+	wrapper.relative = relative
+}
+
+// SetFormat configures the format used when unwrapping and marshalling.
+func (wrapper *WrapperTime) SetFormat(format TimeFormat) {
+	wrapper.format = format
+}
+
 func (wrapper *WrapperTime) Get() time.Time {
 	return wrapper.Value
 }
@@ -38,18 +100,39 @@ func (wrapper *WrapperTime) Wrap(value any, discard bool) error {
 		return wrapper.Wrap(v.UnwrapAny(), discard)
 
 	case time.Time:
-		wrapper.Value = v
+		return wrapper.set(v, value, discard)
+
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		if wrapper.epochUnit == EpochNone {
+			wrapper.Discard()
+			if !discard {
+				return ErrorType(WrapperTimeName, value)
+			}
+			return nil
+		}
+
+		converted, err := timeFromEpoch(v, wrapper.epochUnit)
+		if err != nil {
+			wrapper.Discard()
+			if !discard {
+				return ErrorParse(WrapperTimeName, value, err)
+			}
+			return nil
+		}
+
+		return wrapper.set(converted, value, discard)
 
 	case string:
-		converted, err := time.Parse(time.RFC3339, v)
+		converted, err := wrapper.parse(v)
 		if err != nil {
 			wrapper.Discard()
 			if !discard {
-				return ErrorValue(WrapperTimeName, value, "RFC3339")
+				return ErrorValue(WrapperTimeName, value, strings.Join(wrapper.expected(), ", "))
 			}
+			return nil
 		}
 
-		wrapper.Value = converted
+		return wrapper.set(converted, value, discard)
 
 	default:
 		wrapper.Discard()
@@ -61,15 +144,101 @@ func (wrapper *WrapperTime) Wrap(value any, discard bool) error {
 	return nil
 }
 
+// set validates the time against the configured constraints before storing it.
+func (wrapper *WrapperTime) set(converted time.Time, value any, discard bool) error {
+	if err := wrapper.checkTime(converted); err != nil {
+		wrapper.Discard()
+		if !discard {
+			return ErrorValue(WrapperTimeName, value, err.Error())
+		}
+		return nil
+	}
+
+	if wrapper.location != nil {
+		converted = converted.In(wrapper.location)
+	}
+
+	wrapper.Value = converted
+
+	return nil
+}
+
+// parse tries the configured layouts in order and falls back to numeric epochs if enabled.
+func (wrapper *WrapperTime) parse(value string) (time.Time, error) {
+	layouts := wrapper.layouts
+	if len(layouts) == 0 {
+		layouts = []string{time.RFC3339}
+	}
+
+	converted, err := parseLayouts(layouts, value)
+	if err == nil {
+		return converted, nil
+	}
+
+	if wrapper.epochUnit != EpochNone {
+		if number, numberErr := strconv.ParseFloat(value, 64); numberErr == nil {
+			if integer, integerErr := strconv.ParseInt(value, 10, 64); integerErr == nil {
+				return timeFromEpoch(integer, wrapper.epochUnit)
+			}
+			return timeFromEpoch(number, wrapper.epochUnit)
+		}
+	}
+
+	if wrapper.relative {
+		now := wrapper.now()
+		if wrapper.location != nil {
+			now = now.In(wrapper.location)
+		}
+
+		if converted, relativeErr := parseRelativeTime(value, now); relativeErr == nil {
+			return converted, nil
+		}
+	}
+
+	return time.Time{}, err
+}
+
+// expected describes the accepted input formats for error messages.
+func (wrapper *WrapperTime) expected() []string {
+	expected := []string{"RFC3339"}
+	if len(wrapper.layouts) > 0 {
+		expected = wrapper.layouts
+	}
+
+	if wrapper.epochUnit != EpochNone {
+		expected = append(expected[:len(expected):len(expected)], "Unix epoch")
+	}
+
+	if wrapper.relative {
+		expected = append(expected[:len(expected):len(expected)], "relative time such as \"3 days ago\"")
+	}
+
+	return expected
+}
+
 func (wrapper *WrapperTime) Unwrap() string {
 	if wrapper.IsDiscarded() {
 		return ""
 	}
 
-	return wrapper.Value.Format(time.RFC3339)
+	switch wrapper.format {
+	case TimeFormatRFC3339Nano:
+		return wrapper.Value.Format(time.RFC3339Nano)
+
+	case TimeFormatEpoch:
+		return strconv.FormatInt(timeToEpoch(wrapper.Value, wrapper.epochUnit), 10)
+
+	default:
+		return wrapper.Value.Format(time.RFC3339)
+	}
 }
 
+// UnwrapAny returns the unwrapped value. For the epoch format this is an int64 so that it is marshalled as a JSON number.
 func (wrapper *WrapperTime) UnwrapAny() any {
+	if wrapper.format == TimeFormatEpoch && !wrapper.IsDiscarded() {
+		return timeToEpoch(wrapper.Value, wrapper.epochUnit)
+	}
+
 	return wrapper.Unwrap()
 }
 
@@ -84,3 +253,320 @@ func (wrapper *WrapperTime) UnmarshalJSON(data []byte) error {
 
 	return UnmarshalJSON(data, wrapper)
 }
+
+func (wrapper *WrapperTime) MarshalText() ([]byte, error) {
+	return MarshalText(wrapper)
+}
+
+func (wrapper *WrapperTime) UnmarshalText(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalText(data, wrapper)
+}
+
+func (wrapper *WrapperTime) Scan(src any) error {
+	if wrapper == nil {
+		return fmt.Errorf("scan into nil wrapper")
+	}
+
+	return Scan(src, wrapper)
+}
+
+func (wrapper *WrapperTime) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return MarshalXML(encoder, start, wrapper)
+}
+
+func (wrapper *WrapperTime) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXML(decoder, start, wrapper)
+}
+
+func (wrapper *WrapperTime) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return MarshalXMLAttr(name, wrapper)
+}
+
+func (wrapper *WrapperTime) UnmarshalXMLAttr(attr xml.Attr) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXMLAttr(attr, wrapper)
+}
+
+func (wrapper *WrapperTime) Set(value string) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return Set(value, wrapper)
+}
+
+func (wrapper *WrapperTime) String() string {
+	return String(wrapper)
+}
+
+// Schema describes RFC 3339 strings, or integers if the wrapper is configured to marshal epoch timestamps.
+func (wrapper *WrapperTime) Schema() Schema {
+	if wrapper.format == TimeFormatEpoch {
+		return Schema{Type: "integer", Format: "int64", Examples: SchemaExamples(WrapperTimeExample, wrapper)}
+	}
+
+	return Schema{Type: "string", Format: "date-time", Examples: SchemaExamples(WrapperTimeExample, wrapper)}
+}
+
+// epochUnitDuration returns the duration of a single unit. Auto detection is resolved by the magnitude of the value:
+// values below 1e11 are seconds (until the year 5138), below 1e14 milliseconds, below 1e17 microseconds and nanoseconds otherwise.
+func epochUnitDuration(unit EpochUnit, magnitude float64) time.Duration {
+	if unit == EpochAuto {
+		magnitude = math.Abs(magnitude)
+		switch {
+		case magnitude < 1e11:
+			unit = EpochSeconds
+		case magnitude < 1e14:
+			unit = EpochMilliseconds
+		case magnitude < 1e17:
+			unit = EpochMicroseconds
+		default:
+			unit = EpochNanoseconds
+		}
+	}
+
+	switch unit {
+	case EpochMilliseconds:
+		return time.Millisecond
+	case EpochMicroseconds:
+		return time.Microsecond
+	case EpochNanoseconds:
+		return time.Nanosecond
+	default:
+		return time.Second
+	}
+}
+
+// timeFromEpoch converts a numeric Unix epoch into a UTC time.
+func timeFromEpoch(value any, unit EpochUnit) (time.Time, error) {
+	var whole int64
+	var fraction float64
+
+	switch v := value.(type) {
+	case int:
+		whole = int64(v)
+	case int8:
+		whole = int64(v)
+	case int16:
+		whole = int64(v)
+	case int32:
+		whole = int64(v)
+	case int64:
+		whole = v
+	case uint:
+		whole = int64(v)
+	case uint8:
+		whole = int64(v)
+	case uint16:
+		whole = int64(v)
+	case uint32:
+		whole = int64(v)
+	case uint64:
+		if v > math.MaxInt64 {
+			return time.Time{}, fmt.Errorf("epoch %d out of range", v)
+		}
+		whole = int64(v)
+	case float32:
+		return timeFromEpoch(float64(v), unit)
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) || math.Abs(v) >= math.MaxInt64 {
+			return time.Time{}, fmt.Errorf("epoch %v out of range", v)
+		}
+		integer, frac := math.Modf(v)
+		whole, fraction = int64(integer), frac
+	default:
+		return time.Time{}, fmt.Errorf("unsupported epoch type %T", value)
+	}
+
+	duration := epochUnitDuration(unit, float64(whole))
+	seconds := int64(time.Second / duration)
+
+	return time.Unix(whole/seconds, (whole%seconds)*int64(duration)).
+		Add(time.Duration(fraction * float64(duration))).
+		UTC(), nil
+}
+
+// timeToEpoch converts a time into a Unix epoch in the given unit. Auto detection and EpochNone use seconds.
+func timeToEpoch(value time.Time, unit EpochUnit) int64 {
+	switch unit {
+	case EpochMilliseconds:
+		return value.UnixMilli()
+	case EpochMicroseconds:
+		return value.UnixMicro()
+	case EpochNanoseconds:
+		return value.UnixNano()
+	default:
+		return value.Unix()
+	}
+}
+
+var (
+	relativeOffset = regexp.MustCompile(`^(in )?([+-])?(\d+|an?) ?([a-z]+)( ago)?$`)
+	relativeDay    = regexp.MustCompile(`^(today|tomorrow|yesterday)(?: (?:at )?(.+))?$`)
+	relativeClock  = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))? ?(am|pm)?$`)
+)
+
+// relativeUnits maps unit names to a duration for clock units or to calendar days, months and years for calendar units.
+var relativeUnits = map[string]struct {
+	duration            time.Duration
+	years, months, days int
+}{
+	"s": {duration: time.Second}, "sec": {duration: time.Second}, "secs": {duration: time.Second}, "second": {duration: time.Second}, "seconds": {duration: time.Second},
+	"m": {duration: time.Minute}, "min": {duration: time.Minute}, "mins": {duration: time.Minute}, "minute": {duration: time.Minute}, "minutes": {duration: time.Minute},
+	"h": {duration: time.Hour}, "hr": {duration: time.Hour}, "hrs": {duration: time.Hour}, "hour": {duration: time.Hour}, "hours": {duration: time.Hour},
+	"d": {days: 1}, "day": {days: 1}, "days": {days: 1},
+	"w": {days: 7}, "week": {days: 7}, "weeks": {days: 7},
+	"month": {months: 1}, "months": {months: 1},
+	"y": {years: 1}, "year": {years: 1}, "years": {years: 1},
+}
+
+// relativeMaxYears bounds offsets in calendar units. RFC 3339 only represents the years 0 to 9999.
+const relativeMaxYears = 10000
+
+// relativeUnitsPerYear returns how many of a calendar unit fit into a year, rounding down.
+func relativeUnitsPerYear(years, months, days int) int {
+	switch {
+	case years != 0:
+		return 1
+	case months != 0:
+		return 12 / months
+	default:
+		return 366 / days
+	}
+}
+
+// parseRelativeTime resolves a human relative expression against now. Calendar units (days, weeks, months, years) keep the wall clock time across daylight saving transitions.
+func parseRelativeTime(value string, now time.Time) (time.Time, error) {
+	expression := strings.Join(strings.Fields(strings.ToLower(value)), " ")
+
+	if expression == "now" {
+		return now, nil
+	}
+
+	// Signed Go durations with multiple components such as "+1h30m".
+	if strings.HasPrefix(expression, "+") || strings.HasPrefix(expression, "-") {
+		if duration, err := time.ParseDuration(expression); err == nil {
+			return now.Add(duration), nil
+		}
+	}
+
+	if match := relativeDay.FindStringSubmatch(expression); match != nil {
+		days := map[string]int{"yesterday": -1, "today": 0, "tomorrow": 1}[match[1]]
+		year, month, day := now.Date()
+
+		if match[2] == "" {
+			return time.Date(year, month, day+days, 0, 0, 0, 0, now.Location()), nil
+		}
+
+		hour, minute, err := parseRelativeClock(match[2])
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		return time.Date(year, month, day+days, hour, minute, 0, 0, now.Location()), nil
+	}
+
+	match := relativeOffset.FindStringSubmatch(expression)
+	if match == nil {
+		return time.Time{}, fmt.Errorf("relative time, got %q", value)
+	}
+
+	unit, ok := relativeUnits[match[4]]
+	if !ok {
+		return time.Time{}, fmt.Errorf("relative time unit, got %q", match[4])
+	}
+
+	// Only one of "in", a sign and "ago" may give the direction.
+	directions := 0
+	for _, direction := range []string{match[1], match[2], match[5]} {
+		if direction != "" {
+			directions++
+		}
+	}
+
+	if directions != 1 {
+		return time.Time{}, fmt.Errorf("relative time with one of \"in\", \"ago\" or a sign, got %q", value)
+	}
+
+	amount := 1
+	if match[3] != "a" && match[3] != "an" {
+		var err error
+		if amount, err = strconv.Atoi(match[3]); err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	// Offsets beyond the range of time.Duration, or beyond the years RFC 3339 can represent for calendar units, would overflow.
+	if unit.duration != 0 && int64(amount) > math.MaxInt64/int64(unit.duration) {
+		return time.Time{}, fmt.Errorf("relative time within %s, got %q", time.Duration(math.MaxInt64), value)
+	}
+
+	if unit.duration == 0 && amount > relativeMaxYears*relativeUnitsPerYear(unit.years, unit.months, unit.days) {
+		return time.Time{}, fmt.Errorf("relative time within %d years, got %q", relativeMaxYears, value)
+	}
+
+	if match[2] == "-" || match[5] != "" {
+		amount = -amount
+	}
+
+	if unit.duration != 0 {
+		return now.Add(time.Duration(amount) * unit.duration), nil
+	}
+
+	if unit.days != 0 {
+		return now.AddDate(0, 0, amount*unit.days), nil
+	}
+
+	// Months and years are clamped to the end of the target month, so one month before March 30th is the end of February.
+	year, month, day := now.Date()
+	first := time.Date(year+amount*unit.years, month+time.Month(amount*unit.months), 1, now.Hour(), now.Minute(), now.Second(), now.Nanosecond(), now.Location())
+	last := time.Date(first.Year(), first.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	return first.AddDate(0, 0, min(day, last)-1), nil
+}
+
+// parseRelativeClock parses a time of day such as "9am", "9:30pm", "17:00", "noon" or "midnight". Hours without minutes require "am" or "pm".
+func parseRelativeClock(value string) (int, int, error) {
+	switch value {
+	case "noon":
+		return 12, 0, nil
+	case "midnight":
+		return 0, 0, nil
+	}
+
+	match := relativeClock.FindStringSubmatch(value)
+	if match == nil || (match[2] == "" && match[3] == "") {
+		return 0, 0, fmt.Errorf("time of day such as 9am or 17:00, got %q", value)
+	}
+
+	hour, _ := strconv.Atoi(match[1])
+	minute, _ := strconv.Atoi(match[2])
+
+	if match[3] != "" {
+		if hour < 1 || hour > 12 {
+			return 0, 0, fmt.Errorf("hour between 1 and 12, got %q", value)
+		}
+
+		hour %= 12
+		if match[3] == "pm" {
+			hour += 12
+		}
+	}
+
+	if hour > 23 || minute > 59 {
+		return 0, 0, fmt.Errorf("time of day, got %q", value)
+	}
+
+	return hour, minute, nil
+}
@@ -2,6 +2,7 @@ package wrappers
 
 import (
 	"encoding/json"
+	"math"
 	"testing"
 	"time"
 )
@@ -209,3 +210,298 @@ func TestWrapperTime_JSONUnmarshal(t *testing.T) {
 		})
 	}
 }
+
+// TestWrapperTime_Layouts tests parsing with custom layouts and epoch units.
+func TestWrapperTime_Layouts(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       any
+		layouts     []string
+		epochUnit   EpochUnit
+		want        time.Time
+		wantError   bool
+		wantDiscard bool
+	}{
+		{
+			name:    "Wrap second layout",
+			input:   "2023-10-10 10:10:10",
+			layouts: []string{time.RFC3339, time.DateTime},
+			want:    time.Date(2023, 10, 10, 10, 10, 10, 0, time.UTC),
+		},
+		{
+			name:        "Wrap unknown layout",
+			input:       "10/10/2023",
+			layouts:     []string{time.RFC3339, time.DateTime},
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap number without epoch unit",
+			input:       float64(1700000000),
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:      "Wrap epoch seconds",
+			input:     int64(1700000000),
+			epochUnit: EpochSeconds,
+			want:      time.Unix(1700000000, 0).UTC(),
+		},
+		{
+			name:      "Wrap epoch milliseconds",
+			input:     int64(1700000000123),
+			epochUnit: EpochMilliseconds,
+			want:      time.UnixMilli(1700000000123).UTC(),
+		},
+		{
+			name:      "Wrap fractional epoch seconds",
+			input:     1700000000.5,
+			epochUnit: EpochSeconds,
+			want:      time.Unix(1700000000, 500000000).UTC(),
+		},
+		{
+			name:      "Wrap auto detected seconds",
+			input:     float64(1700000000),
+			epochUnit: EpochAuto,
+			want:      time.Unix(1700000000, 0).UTC(),
+		},
+		{
+			name:      "Wrap auto detected milliseconds",
+			input:     float64(1700000000123),
+			epochUnit: EpochAuto,
+			want:      time.UnixMilli(1700000000123).UTC(),
+		},
+		{
+			name:      "Wrap auto detected microseconds",
+			input:     int64(1700000000123456),
+			epochUnit: EpochAuto,
+			want:      time.UnixMicro(1700000000123456).UTC(),
+		},
+		{
+			name:      "Wrap auto detected nanoseconds",
+			input:     int64(1700000000123456789),
+			epochUnit: EpochAuto,
+			want:      time.Unix(0, 1700000000123456789).UTC(),
+		},
+		{
+			name:      "Wrap numeric string",
+			input:     "1700000000",
+			epochUnit: EpochAuto,
+			want:      time.Unix(1700000000, 0).UTC(),
+		},
+		{
+			name:        "Wrap invalid float",
+			input:       math.Inf(1),
+			epochUnit:   EpochAuto,
+			wantError:   true,
+			wantDiscard: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapper := New[*WrapperTime]()
+			wrapper.SetLayouts(tt.layouts)
+			wrapper.SetEpochUnit(tt.epochUnit)
+
+			err := wrapper.Wrap(tt.input, false)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("Wrap() error = %v, wantError %v", err, tt.wantError)
+			}
+
+			if wrapper.IsDiscarded() != tt.wantDiscard {
+				t.Errorf("IsDiscarded() = %v, want %v", wrapper.IsDiscarded(), tt.wantDiscard)
+			}
+
+			if !wrapper.IsDiscarded() && !wrapper.Get().Equal(tt.want) {
+				t.Errorf("Get() = %v, want %v", wrapper.Get(), tt.want)
+			}
+		})
+	}
+}
+
+// TestWrapperTime_Format tests the marshal formats of WrapperTime.
+func TestWrapperTime_Format(t *testing.T) {
+	value := time.Date(2023, 11, 14, 22, 13, 20, 123000000, time.UTC)
+
+	tests := []struct {
+		name         string
+		format       TimeFormat
+		epochUnit    EpochUnit
+		want         string
+		expectedJSON string
+	}{
+		{
+			name:         "RFC3339",
+			format:       TimeFormatRFC3339,
+			want:         "2023-11-14T22:13:20Z",
+			expectedJSON: `"2023-11-14T22:13:20Z"`,
+		},
+		{
+			name:         "RFC3339Nano",
+			format:       TimeFormatRFC3339Nano,
+			want:         "2023-11-14T22:13:20.123Z",
+			expectedJSON: `"2023-11-14T22:13:20.123Z"`,
+		},
+		{
+			name:         "Epoch seconds",
+			format:       TimeFormatEpoch,
+			want:         "1700000000",
+			expectedJSON: `1700000000`,
+		},
+		{
+			name:         "Epoch milliseconds",
+			format:       TimeFormatEpoch,
+			epochUnit:    EpochMilliseconds,
+			want:         "1700000000123",
+			expectedJSON: `1700000000123`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapper := New[*WrapperTime]()
+			wrapper.SetFormat(tt.format)
+			wrapper.SetEpochUnit(tt.epochUnit)
+
+			if err := wrapper.Wrap(value, false); err != nil {
+				t.Fatalf("Wrap() error = %v", err)
+			}
+
+			if wrapper.Unwrap() != tt.want {
+				t.Errorf("Unwrap() = %v, want %v", wrapper.Unwrap(), tt.want)
+			}
+
+			data, err := json.Marshal(wrapper)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+
+			if string(data) != tt.expectedJSON {
+				t.Errorf("Marshalled JSON = %v, want %v", string(data), tt.expectedJSON)
+			}
+		})
+	}
+}
+
+// TestWrapperTime_Location tests normalizing WrapperTime values into a location.
+func TestWrapperTime_Location(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("LoadLocation() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		location *time.Location
+		want     string
+	}{
+		{name: "Keep parsed location", location: nil, want: "2024-01-01T12:00:00-05:00"},
+		{name: "Normalize to UTC", location: time.UTC, want: "2024-01-01T17:00:00Z"},
+		{name: "Normalize to location", location: berlin, want: "2024-01-01T18:00:00+01:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapper := New[*WrapperTime]()
+			wrapper.SetLocation(tt.location)
+
+			if err := wrapper.Wrap("2024-01-01T12:00:00-05:00", false); err != nil {
+				t.Fatalf("Wrap() error = %v", err)
+			}
+
+			if wrapper.Unwrap() != tt.want {
+				t.Errorf("Unwrap() = %v, want %v", wrapper.Unwrap(), tt.want)
+			}
+		})
+	}
+}
+
+// TestWrapperTime_Relative tests the relative input mode of WrapperTime against a fixed clock.
+func TestWrapperTime_Relative(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("LoadLocation() error = %v", err)
+	}
+
+	// Daylight saving begins in Berlin the following night.
+	now := time.Date(2024, time.March, 30, 12, 0, 0, 0, berlin)
+
+	tests := []struct {
+		name      string
+		input     string
+		relative  bool
+		location  *time.Location
+		want      string
+		wantError bool
+	}{
+		{name: "Now", input: "now", relative: true, want: "2024-03-30T12:00:00+01:00"},
+		{name: "Now with whitespace and case", input: "  NOW ", relative: true, want: "2024-03-30T12:00:00+01:00"},
+		{name: "Today", input: "today", relative: true, want: "2024-03-30T00:00:00+01:00"},
+		{name: "Yesterday", input: "yesterday", relative: true, want: "2024-03-29T00:00:00+01:00"},
+		{name: "Tomorrow morning", input: "tomorrow 9am", relative: true, want: "2024-03-31T09:00:00+02:00"},
+		{name: "Tomorrow at time", input: "tomorrow at 9:30pm", relative: true, want: "2024-03-31T21:30:00+02:00"},
+		{name: "Today 24 hour clock", input: "today 17:45", relative: true, want: "2024-03-30T17:45:00+01:00"},
+		{name: "Today noon", input: "today noon", relative: true, want: "2024-03-30T12:00:00+01:00"},
+		{name: "Today 12am", input: "today 12am", relative: true, want: "2024-03-30T00:00:00+01:00"},
+		{name: "Days ago", input: "3 days ago", relative: true, want: "2024-03-27T12:00:00+01:00"},
+		{name: "In hours", input: "in 2 hours", relative: true, want: "2024-03-30T14:00:00+01:00"},
+		{name: "An hour ago", input: "an hour ago", relative: true, want: "2024-03-30T11:00:00+01:00"},
+		{name: "Compact ago", input: "2h ago", relative: true, want: "2024-03-30T10:00:00+01:00"},
+		{name: "Signed duration", input: "+2h", relative: true, want: "2024-03-30T14:00:00+01:00"},
+		{name: "Signed compound duration", input: "-1h30m", relative: true, want: "2024-03-30T10:30:00+01:00"},
+		{name: "Calendar day across daylight saving", input: "in 1 day", relative: true, want: "2024-03-31T12:00:00+02:00"},
+		{name: "Hours across daylight saving", input: "+24h", relative: true, want: "2024-03-31T13:00:00+02:00"},
+		{name: "Weeks", input: "+2 weeks", relative: true, want: "2024-04-13T12:00:00+02:00"},
+		{name: "Months ago", input: "1 month ago", relative: true, want: "2024-02-29T12:00:00+01:00"},
+		{name: "Location", input: "now", relative: true, location: time.UTC, want: "2024-03-30T11:00:00Z"},
+		{name: "RFC 3339 still accepted", input: "2024-01-01T00:00:00Z", relative: true, want: "2024-01-01T00:00:00Z"},
+		{name: "Disabled by default", input: "now", wantError: true},
+		{name: "Missing direction", input: "2 hours", relative: true, wantError: true},
+		{name: "Double direction", input: "in 2 hours ago", relative: true, wantError: true},
+		{name: "Unknown unit", input: "in 2 fortnights", relative: true, wantError: true},
+		{name: "Ambiguous hour", input: "tomorrow 9", relative: true, wantError: true},
+		{name: "Invalid 12 hour clock", input: "tomorrow 13pm", relative: true, wantError: true},
+		{name: "Overflowing duration", input: "in 9999999999 hours", relative: true, wantError: true},
+		{name: "Overflowing duration ago", input: "9999999999 seconds ago", relative: true, wantError: true},
+		{name: "Overflowing weeks", input: "in 9999999999 weeks", relative: true, wantError: true},
+		{name: "Overflowing years", input: "-10001 years", relative: true, wantError: true},
+		{name: "Largest duration", input: "in 2562047 hours", relative: true, want: "2316-07-10T12:00:00+02:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapper := New[*WrapperTime]()
+			wrapper.SetClock(ClockFunc(func() time.Time { return now }))
+			wrapper.SetRelative(tt.relative)
+			wrapper.SetLocation(tt.location)
+
+			err := wrapper.Wrap(tt.input, false)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("Wrap() error = %v, wantError %v", err, tt.wantError)
+			}
+
+			if !tt.wantError && wrapper.Unwrap() != tt.want {
+				t.Errorf("Unwrap() = %v, want %v", wrapper.Unwrap(), tt.want)
+			}
+		})
+	}
+}
+
+// TestWrapperTime_RelativeConstraints tests that relative input is validated against the time constraints.
+func TestWrapperTime_RelativeConstraints(t *testing.T) {
+	now := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	wrapper := New[*WrapperTime]()
+	wrapper.SetClock(ClockFunc(func() time.Time { return now }))
+	wrapper.SetRelative(true)
+	wrapper.SetPast(true)
+
+	if err := wrapper.Wrap("yesterday", false); err != nil {
+		t.Errorf("Wrap() error = %v", err)
+	}
+
+	if err := wrapper.Wrap("in 3 days", false); err == nil {
+		t.Errorf("Wrap() expected error for future time")
+	}
+}
@@ -1,18 +1,56 @@
 package wrappers
 
 import (
+	"encoding/xml"
 	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 )
 
 const (
-	WrapperTimeDurationName Name = "WrapperTimeDuration"
+	WrapperTimeDurationName    Name   = "WrapperTimeDuration"
+	WrapperTimeDurationExample string = "1h30m, PT1H30M, P2D, 5400"
 )
 
-type WrapperTimeDuration Wrapper[time.Duration, string]
+// DurationFormat defines how a wrapped duration is unwrapped and marshalled.
+type DurationFormat int
+
+const (
+	DurationFormatGo      DurationFormat = iota // Go duration syntax as produced by time.Duration.String, e.g. "1h30m0s".
+	DurationFormatISO8601                       // ISO 8601 duration syntax, e.g. "PT1H30M".
+)
+
+var iso8601Duration = regexp.MustCompile(`^([+-])?P(?:(\d+(?:[.,]\d+)?)Y)?(?:(\d+(?:[.,]\d+)?)M)?(?:(\d+(?:[.,]\d+)?)W)?(?:(\d+(?:[.,]\d+)?)D)?(?:T(?:(\d+(?:[.,]\d+)?)H)?(?:(\d+(?:[.,]\d+)?)M)?(?:(\d+(?:[.,]\d+)?)S)?)?$`)
+
+// WrapperTimeDuration wraps a time.Duration. Strings are accepted in Go ("1h30m") and ISO 8601 ("PT1H30M") syntax.
+// Numbers are interpreted in the configured unit, which defaults to seconds.
+type WrapperTimeDuration struct {
+	Wrapper[time.Duration, string]
+	unit           time.Duration  // Unit of numeric values. Defaults to time.Second.
+	rejectNegative bool           // If true, negative durations are rejected.
+	format         DurationFormat // Format used when unwrapping and marshalling. Defaults to DurationFormatGo.
+}
 
 var _ WrapperProvider = (*WrapperTimeDuration)(nil) // Ensure that WrapperTimeDuration implements WrapperProvider.
 
+// SetUnit sets the unit numeric values are interpreted in, e.g. time.Millisecond.
+func (wrapper *WrapperTimeDuration) SetUnit(unit time.Duration) {
+	wrapper.unit = unit
+}
+
+// SetRejectNegative configures whether negative durations are rejected.
+func (wrapper *WrapperTimeDuration) SetRejectNegative(reject bool) {
+	wrapper.rejectNegative = reject
+}
+
+// SetFormat configures the format used when unwrapping and marshalling.
+func (wrapper *WrapperTimeDuration) SetFormat(format DurationFormat) {
+	wrapper.format = format
+}
+
 func (wrapper *WrapperTimeDuration) Get() time.Duration {
 	return wrapper.Value
 }
@@ -38,25 +76,39 @@ func (wrapper *WrapperTimeDuration) Wrap(value any, discard bool) error {
 		return wrapper.Wrap(v.UnwrapAny(), discard)
 
 	case time.Duration:
-		wrapper.Value = v
+		return wrapper.set(v, value, discard)
 
-	case int, int8, int16, int32, int64:
-		wrapper.Value = time.Duration(v.(int))
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		converted, err := wrapper.fromNumber(v)
+		if err != nil {
+			wrapper.Discard()
+			if !discard {
+				return ErrorParse(WrapperTimeDurationName, value, err)
+			}
+			return nil
+		}
 
-	case float32, float64:
-		wrapper.Value = time.Duration(v.(float64))
+		return wrapper.set(converted, value, discard)
 
 	case string:
-		converted, err := time.ParseDuration(v)
+		var converted time.Duration
+		var err error
+
+		if strings.HasPrefix(strings.TrimLeft(v, "+-"), "P") {
+			converted, err = ParseISO8601Duration(v)
+		} else {
+			converted, err = time.ParseDuration(v)
+		}
+
 		if err != nil {
 			wrapper.Discard()
 			if !discard {
-				return ErrorValue(WrapperTimeDurationName, value, "time.Duration")
+				return ErrorValue(WrapperTimeDurationName, value, "time.Duration or ISO 8601 duration")
 			}
 			return nil
 		}
 
-		wrapper.Value = converted
+		return wrapper.set(converted, value, discard)
 
 	default:
 		wrapper.Discard()
@@ -68,12 +120,91 @@ func (wrapper *WrapperTimeDuration) Wrap(value any, discard bool) error {
 	return nil
 }
 
+// set validates the duration before storing it.
+func (wrapper *WrapperTimeDuration) set(converted time.Duration, value any, discard bool) error {
+	if wrapper.rejectNegative && converted < 0 {
+		wrapper.Discard()
+		if !discard {
+			return ErrorValue(WrapperTimeDurationName, value, "non-negative duration")
+		}
+		return nil
+	}
+
+	wrapper.Value = converted
+
+	return nil
+}
+
+// fromNumber converts a number in the configured unit into a duration.
+func (wrapper *WrapperTimeDuration) fromNumber(value any) (time.Duration, error) {
+	unit := wrapper.unit
+	if unit <= 0 {
+		unit = time.Second
+	}
+
+	// Integers are multiplied exactly to avoid losing precision for large values. Only floats are converted through float64.
+	var integer int64
+	switch v := value.(type) {
+	case int:
+		integer = int64(v)
+	case int8:
+		integer = int64(v)
+	case int16:
+		integer = int64(v)
+	case int32:
+		integer = int64(v)
+	case int64:
+		integer = v
+	case uint:
+		if uint64(v) > math.MaxInt64 {
+			return 0, fmt.Errorf("duration %d%s out of range", v, unit)
+		}
+		integer = int64(v)
+	case uint8:
+		integer = int64(v)
+	case uint16:
+		integer = int64(v)
+	case uint32:
+		integer = int64(v)
+	case uint64:
+		if v > math.MaxInt64 {
+			return 0, fmt.Errorf("duration %d%s out of range", v, unit)
+		}
+		integer = int64(v)
+	case float32:
+		return fromFloat(float64(v), unit)
+	case float64:
+		return fromFloat(v, unit)
+	}
+
+	if integer > math.MaxInt64/int64(unit) || integer < math.MinInt64/int64(unit) {
+		return 0, fmt.Errorf("duration %d%s out of range", integer, unit)
+	}
+
+	return time.Duration(integer) * unit, nil
+}
+
+// fromFloat converts a floating point number in the unit into a duration.
+func fromFloat(number float64, unit time.Duration) (time.Duration, error) {
+	result := number * float64(unit)
+	if math.IsNaN(result) || result >= math.MaxInt64 || result <= math.MinInt64 {
+		return 0, fmt.Errorf("duration %v%s out of range", number, unit)
+	}
+
+	return time.Duration(result), nil
+}
+
 func (wrapper *WrapperTimeDuration) Unwrap() string {
+	value := wrapper.Value
 	if wrapper.IsDiscarded() {
-		return "0s"
+		value = 0
+	}
+
+	if wrapper.format == DurationFormatISO8601 {
+		return FormatISO8601Duration(value)
 	}
 
-	return wrapper.Value.String()
+	return value.String()
 }
 
 func (wrapper *WrapperTimeDuration) UnwrapAny() any {
@@ -91,3 +222,159 @@ func (wrapper *WrapperTimeDuration) UnmarshalJSON(data []byte) error {
 
 	return UnmarshalJSON(data, wrapper)
 }
+
+func (wrapper *WrapperTimeDuration) MarshalText() ([]byte, error) {
+	return MarshalText(wrapper)
+}
+
+func (wrapper *WrapperTimeDuration) UnmarshalText(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalText(data, wrapper)
+}
+
+func (wrapper *WrapperTimeDuration) Scan(src any) error {
+	if wrapper == nil {
+		return fmt.Errorf("scan into nil wrapper")
+	}
+
+	return Scan(src, wrapper)
+}
+
+func (wrapper *WrapperTimeDuration) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return MarshalXML(encoder, start, wrapper)
+}
+
+func (wrapper *WrapperTimeDuration) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXML(decoder, start, wrapper)
+}
+
+func (wrapper *WrapperTimeDuration) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return MarshalXMLAttr(name, wrapper)
+}
+
+func (wrapper *WrapperTimeDuration) UnmarshalXMLAttr(attr xml.Attr) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXMLAttr(attr, wrapper)
+}
+
+func (wrapper *WrapperTimeDuration) Set(value string) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return Set(value, wrapper)
+}
+
+func (wrapper *WrapperTimeDuration) String() string {
+	return String(wrapper)
+}
+
+// Schema describes durations in the configured format, i.e. Go duration strings by default or ISO 8601 durations.
+func (wrapper *WrapperTimeDuration) Schema() Schema {
+	if wrapper.format == DurationFormatISO8601 {
+		return Schema{Type: "string", Format: "duration", Examples: SchemaExamples(WrapperTimeDurationExample, wrapper)}
+	}
+
+	return Schema{Type: "string", Pattern: `^-?([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`, Examples: SchemaExamples(WrapperTimeDurationExample, wrapper)}
+}
+
+// ParseISO8601Duration parses an ISO 8601 duration such as "PT1H30M", "P2D" or "P1W". Decimal fractions are allowed on any component.
+// Years and months have no fixed length and are therefore rejected. Days are 24 hours long. A leading sign is accepted as an extension.
+func ParseISO8601Duration(value string) (time.Duration, error) {
+	match := iso8601Duration.FindStringSubmatch(value)
+	if match == nil || strings.HasSuffix(value, "P") || strings.HasSuffix(value, "T") {
+		return 0, fmt.Errorf("ISO 8601 duration, got %q", value)
+	}
+
+	if match[2] != "" || match[3] != "" {
+		return 0, fmt.Errorf("ISO 8601 duration without years or months, got %q", value)
+	}
+
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+
+	var total float64
+	for i, unit := range units {
+		component := match[4+i]
+		if component == "" {
+			continue
+		}
+
+		number, err := strconv.ParseFloat(strings.Replace(component, ",", ".", 1), 64)
+		if err != nil {
+			return 0, err
+		}
+
+		total += number * float64(unit)
+	}
+
+	if total >= math.MaxInt64 {
+		return 0, fmt.Errorf("ISO 8601 duration %q out of range", value)
+	}
+
+	duration := time.Duration(math.Round(total))
+	if match[1] == "-" {
+		duration = -duration
+	}
+
+	return duration, nil
+}
+
+// FormatISO8601Duration formats a duration in ISO 8601 syntax using days, hours, minutes and seconds, e.g. "P1DT2H30M".
+func FormatISO8601Duration(duration time.Duration) string {
+	if duration == 0 {
+		return "PT0S"
+	}
+
+	var builder strings.Builder
+
+	// Use the unsigned magnitude so the minimum duration does not overflow when negated.
+	magnitude := uint64(duration)
+	if duration < 0 {
+		builder.WriteString("-")
+		magnitude = uint64(-(duration + 1)) + 1
+	}
+
+	builder.WriteString("P")
+
+	day := uint64(24 * time.Hour)
+	if days := magnitude / day; days > 0 {
+		builder.WriteString(strconv.FormatUint(days, 10) + "D")
+		magnitude %= day
+	}
+
+	if magnitude == 0 {
+		return builder.String()
+	}
+
+	builder.WriteString("T")
+
+	if hours := magnitude / uint64(time.Hour); hours > 0 {
+		builder.WriteString(strconv.FormatUint(hours, 10) + "H")
+		magnitude %= uint64(time.Hour)
+	}
+
+	if minutes := magnitude / uint64(time.Minute); minutes > 0 {
+		builder.WriteString(strconv.FormatUint(minutes, 10) + "M")
+		magnitude %= uint64(time.Minute)
+	}
+
+	if magnitude > 0 {
+		seconds := strconv.FormatUint(magnitude/uint64(time.Second), 10)
+		if fraction := magnitude % uint64(time.Second); fraction > 0 {
+			seconds += "." + strings.TrimRight(fmt.Sprintf("%09d", fraction), "0")
+		}
+		builder.WriteString(seconds + "S")
+	}
+
+	return builder.String()
+}
@@ -2,6 +2,7 @@ package wrappers
 
 import (
 	"encoding/json"
+	"math"
 	"testing"
 	"time"
 )
@@ -191,10 +192,10 @@ func TestWrapperTimeDuration_JSONUnmarshal(t *testing.T) {
 		},
 		{
 			name:        "Unmarshal valid duration number (seconds)",
-			jsonInput:   `3600`, // 3.6 microseconds
-			want:        "3.6µs",
+			jsonInput:   `3600`, // Numbers default to seconds
+			want:        "1h0m0s",
 			wantDiscard: false,
-			wantError:   false, // Unsupported type during unmarshalling
+			wantError:   false,
 		},
 		{
 			name:        "Unmarshal invalid duration string without discard",
@@ -263,3 +264,104 @@ func TestWrapperTimeDuration_JSONUnmarshal(t *testing.T) {
 		})
 	}
 }
+
+// TestWrapperTimeDuration_Options tests ISO 8601 durations, numeric units and negative rejection of WrapperTimeDuration.
+func TestWrapperTimeDuration_Options(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          any
+		unit           time.Duration
+		rejectNegative bool
+		want           time.Duration
+		wantError      bool
+		wantDiscard    bool
+	}{
+		{name: "Wrap ISO 8601 hours and minutes", input: "PT1H30M", want: 90 * time.Minute},
+		{name: "Wrap ISO 8601 days", input: "P2D", want: 48 * time.Hour},
+		{name: "Wrap ISO 8601 weeks", input: "P1W", want: 7 * 24 * time.Hour},
+		{name: "Wrap ISO 8601 combined", input: "P1DT2H3M4.5S", want: 26*time.Hour + 3*time.Minute + 4500*time.Millisecond},
+		{name: "Wrap ISO 8601 fraction with comma", input: "PT0,5H", want: 30 * time.Minute},
+		{name: "Wrap ISO 8601 negative", input: "-PT15M", want: -15 * time.Minute},
+		{name: "Wrap ISO 8601 months", input: "P1M", wantError: true, wantDiscard: true},
+		{name: "Wrap ISO 8601 empty", input: "PT", wantError: true, wantDiscard: true},
+		{name: "Wrap ISO 8601 invalid order", input: "PT30M1H", wantError: true, wantDiscard: true},
+		{name: "Wrap int seconds", input: 90, want: 90 * time.Second},
+		{name: "Wrap int64 seconds", input: int64(90), want: 90 * time.Second},
+		{name: "Wrap float32 seconds", input: float32(1.5), want: 1500 * time.Millisecond},
+		{name: "Wrap float64 seconds", input: float64(0.25), want: 250 * time.Millisecond},
+		{name: "Wrap milliseconds", input: float64(1500), unit: time.Millisecond, want: 1500 * time.Millisecond},
+		{name: "Wrap out of range", input: int64(math.MaxInt64), wantError: true, wantDiscard: true},
+		{name: "Wrap large int nanoseconds exactly", input: int(1<<53 + 1), unit: time.Nanosecond, want: time.Duration(1<<53 + 1)},
+		{name: "Wrap large uint64 nanoseconds exactly", input: uint64(math.MaxInt64), unit: time.Nanosecond, want: time.Duration(math.MaxInt64)},
+		{name: "Wrap uint seconds", input: uint(90), want: 90 * time.Second},
+		{name: "Wrap uint64 overflow", input: uint64(math.MaxUint64), unit: time.Nanosecond, wantError: true, wantDiscard: true},
+		{name: "Wrap uint overflow", input: uint(math.MaxInt64) + 1, unit: time.Nanosecond, wantError: true, wantDiscard: true},
+		{name: "Wrap int32 out of range", input: int32(math.MaxInt32), unit: time.Hour, wantError: true, wantDiscard: true},
+		{name: "Wrap float64 out of range", input: float64(math.MaxInt64), wantError: true, wantDiscard: true},
+		{name: "Wrap negative", input: "-5m", want: -5 * time.Minute},
+		{name: "Wrap negative rejected", input: "-5m", rejectNegative: true, wantError: true, wantDiscard: true},
+		{name: "Wrap negative number rejected", input: -1, rejectNegative: true, wantError: true, wantDiscard: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapper := New[*WrapperTimeDuration]()
+			wrapper.SetUnit(tt.unit)
+			wrapper.SetRejectNegative(tt.rejectNegative)
+
+			err := wrapper.Wrap(tt.input, false)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("Wrap() error = %v, wantError %v", err, tt.wantError)
+			}
+
+			if wrapper.IsDiscarded() != tt.wantDiscard {
+				t.Errorf("IsDiscarded() = %v, want %v", wrapper.IsDiscarded(), tt.wantDiscard)
+			}
+
+			if !wrapper.IsDiscarded() && wrapper.Get() != tt.want {
+				t.Errorf("Get() = %v, want %v", wrapper.Get(), tt.want)
+			}
+		})
+	}
+}
+
+// TestWrapperTimeDuration_FormatISO8601 tests marshalling WrapperTimeDuration in ISO 8601 format.
+func TestWrapperTimeDuration_FormatISO8601(t *testing.T) {
+	tests := []struct {
+		name  string
+		input time.Duration
+		want  string
+	}{
+		{name: "Zero", input: 0, want: "PT0S"},
+		{name: "Hours and minutes", input: 90 * time.Minute, want: "PT1H30M"},
+		{name: "Days", input: 48 * time.Hour, want: "P2D"},
+		{name: "Days and seconds", input: 24*time.Hour + 1500*time.Millisecond, want: "P1DT1.5S"},
+		{name: "Negative", input: -15 * time.Minute, want: "-PT15M"},
+		{name: "Minimum", input: math.MinInt64, want: "-P106751DT23H47M16.854775808S"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapper := New[*WrapperTimeDuration]()
+			wrapper.SetFormat(DurationFormatISO8601)
+
+			if err := wrapper.Wrap(tt.input, false); err != nil {
+				t.Fatalf("Wrap() error = %v", err)
+			}
+
+			data, err := json.Marshal(wrapper)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+
+			if string(data) != `"`+tt.want+`"` {
+				t.Errorf("Marshalled JSON = %v, want %v", string(data), tt.want)
+			}
+
+			parsed, err := ParseISO8601Duration(tt.want)
+			if tt.input != math.MinInt64 && (err != nil || parsed != tt.input) {
+				t.Errorf("ParseISO8601Duration() = %v, %v, want %v", parsed, err, tt.input)
+			}
+		})
+	}
+}
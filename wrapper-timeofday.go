@@ -0,0 +1,251 @@
+package wrappers
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	WrapperTimeOfDayName    Name   = "WrapperTimeOfDay"
+	WrapperTimeOfDayExample string = "09:30, 17:45:30"
+)
+
+// TimeOfDay is a wall clock time without a date or location.
+type TimeOfDay struct {
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+}
+
+// TimeOfDayOf returns the time of day of a time in its own location.
+func TimeOfDayOf(value time.Time) TimeOfDay {
+	return TimeOfDay{Hour: value.Hour(), Minute: value.Minute(), Second: value.Second(), Nanosecond: value.Nanosecond()}
+}
+
+// IsValid checks if all components are within their range.
+func (timeOfDay TimeOfDay) IsValid() bool {
+	return timeOfDay.Hour >= 0 && timeOfDay.Hour < 24 &&
+		timeOfDay.Minute >= 0 && timeOfDay.Minute < 60 &&
+		timeOfDay.Second >= 0 && timeOfDay.Second < 60 &&
+		timeOfDay.Nanosecond >= 0 && timeOfDay.Nanosecond < int(time.Second)
+}
+
+// Duration returns the time elapsed since midnight.
+func (timeOfDay TimeOfDay) Duration() time.Duration {
+	return time.Duration(timeOfDay.Hour)*time.Hour +
+		time.Duration(timeOfDay.Minute)*time.Minute +
+		time.Duration(timeOfDay.Second)*time.Second +
+		time.Duration(timeOfDay.Nanosecond)
+}
+
+// On returns the time of day on the given date in the given location.
+func (timeOfDay TimeOfDay) On(date Date, location *time.Location) time.Time {
+	return time.Date(date.Year, date.Month, date.Day, timeOfDay.Hour, timeOfDay.Minute, timeOfDay.Second, timeOfDay.Nanosecond, location)
+}
+
+// Compare returns -1 if the time of day is before the other, 1 if it is after and 0 if they are equal.
+func (timeOfDay TimeOfDay) Compare(other TimeOfDay) int {
+	switch {
+	case timeOfDay.Duration() < other.Duration():
+		return -1
+	case timeOfDay.Duration() > other.Duration():
+		return 1
+	default:
+		return 0
+	}
+}
+
+func (timeOfDay TimeOfDay) Before(other TimeOfDay) bool {
+	return timeOfDay.Compare(other) < 0
+}
+
+func (timeOfDay TimeOfDay) After(other TimeOfDay) bool {
+	return timeOfDay.Compare(other) > 0
+}
+
+// String returns the time of day in its canonical form: "15:04" without seconds, "15:04:05" with seconds and fractional seconds if present.
+func (timeOfDay TimeOfDay) String() string {
+	value := timeOfDay.On(Date{Year: 2000, Month: time.January, Day: 1}, time.UTC)
+
+	switch {
+	case timeOfDay.Nanosecond != 0:
+		return value.Format("15:04:05.999999999")
+	case timeOfDay.Second != 0:
+		return value.Format("15:04:05")
+	default:
+		return value.Format("15:04")
+	}
+}
+
+// WrapperTimeOfDay wraps a wall clock time. By default "15:04:05" and "15:04" strings are accepted.
+type WrapperTimeOfDay struct {
+	Wrapper[TimeOfDay, string]
+	layouts []string // Layouts tried in order when parsing strings.
+}
+
+var _ WrapperProvider = (*WrapperTimeOfDay)(nil) // Ensure that WrapperTimeOfDay implements WrapperProvider.
+
+// SetLayouts sets the layouts (see time.Parse) that are tried in order when parsing strings. Times of day are always unwrapped in their canonical form.
+func (wrapper *WrapperTimeOfDay) SetLayouts(layouts []string) {
+	wrapper.layouts = layouts
+}
+
+func (wrapper *WrapperTimeOfDay) Get() TimeOfDay {
+	return wrapper.Value
+}
+
+func (wrapper *WrapperTimeOfDay) GetAny() any {
+	return wrapper.Get()
+}
+
+func (wrapper *WrapperTimeOfDay) Wrap(value any, discard bool) error {
+	switch v := value.(type) {
+	case nil:
+		wrapper.Discard()
+		if !discard {
+			return ErrorNil(WrapperTimeOfDayName)
+		}
+
+	case WrapperProvider:
+		if v.IsDiscarded() {
+			wrapper.Discard()
+			return nil
+		}
+
+		return wrapper.Wrap(v.UnwrapAny(), discard)
+
+	case TimeOfDay:
+		if !v.IsValid() {
+			wrapper.Discard()
+			if !discard {
+				return ErrorValue(WrapperTimeOfDayName, value, "valid time of day")
+			}
+			return nil
+		}
+
+		wrapper.Value = v
+
+	case time.Time:
+		wrapper.Value = TimeOfDayOf(v)
+
+	case string:
+		if v == "" {
+			wrapper.Discard()
+			if !discard {
+				return ErrorNil(WrapperTimeOfDayName)
+			}
+			return nil
+		}
+
+		layouts := wrapper.layouts
+		if len(layouts) == 0 {
+			layouts = []string{time.TimeOnly, "15:04"}
+		}
+
+		parsed, err := parseLayouts(layouts, v)
+		if err != nil {
+			wrapper.Discard()
+			if !discard {
+				return ErrorValue(WrapperTimeOfDayName, value, strings.Join(layouts, ", "))
+			}
+			return nil
+		}
+
+		wrapper.Value = TimeOfDayOf(parsed)
+
+	default:
+		wrapper.Discard()
+		if !discard {
+			return ErrorType(WrapperTimeOfDayName, value)
+		}
+	}
+
+	return nil
+}
+
+func (wrapper *WrapperTimeOfDay) Unwrap() string {
+	if wrapper.IsDiscarded() {
+		return ""
+	}
+
+	return wrapper.Value.String()
+}
+
+func (wrapper *WrapperTimeOfDay) UnwrapAny() any {
+	return wrapper.Unwrap()
+}
+
+func (wrapper *WrapperTimeOfDay) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(wrapper)
+}
+
+func (wrapper *WrapperTimeOfDay) UnmarshalJSON(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalJSON(data, wrapper)
+}
+
+func (wrapper *WrapperTimeOfDay) MarshalText() ([]byte, error) {
+	return MarshalText(wrapper)
+}
+
+func (wrapper *WrapperTimeOfDay) UnmarshalText(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalText(data, wrapper)
+}
+
+func (wrapper *WrapperTimeOfDay) Scan(src any) error {
+	if wrapper == nil {
+		return fmt.Errorf("scan into nil wrapper")
+	}
+
+	return Scan(src, wrapper)
+}
+
+func (wrapper *WrapperTimeOfDay) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return MarshalXML(encoder, start, wrapper)
+}
+
+func (wrapper *WrapperTimeOfDay) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXML(decoder, start, wrapper)
+}
+
+func (wrapper *WrapperTimeOfDay) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return MarshalXMLAttr(name, wrapper)
+}
+
+func (wrapper *WrapperTimeOfDay) UnmarshalXMLAttr(attr xml.Attr) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXMLAttr(attr, wrapper)
+}
+
+func (wrapper *WrapperTimeOfDay) Set(value string) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return Set(value, wrapper)
+}
+
+func (wrapper *WrapperTimeOfDay) String() string {
+	return String(wrapper)
+}
+
+func (wrapper *WrapperTimeOfDay) Schema() Schema {
+	return Schema{Type: "string", Pattern: `^([01][0-9]|2[0-3]):[0-5][0-9](:[0-5][0-9](\.[0-9]{1,9})?)?$`, Examples: SchemaExamples(WrapperTimeOfDayExample, wrapper)}
+}
@@ -0,0 +1,149 @@
+package wrappers
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// TestWrapperTimeOfDay_Wrap tests the Wrap method of WrapperTimeOfDay.
+func TestWrapperTimeOfDay_Wrap(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       any
+		layouts     []string
+		discard     bool
+		want        string
+		wantError   bool
+		wantDiscard bool
+	}{
+		{
+			name:  "Wrap hours and minutes",
+			input: "09:30",
+			want:  "09:30",
+		},
+		{
+			name:  "Wrap seconds",
+			input: "17:45:30",
+			want:  "17:45:30",
+		},
+		{
+			name:  "Wrap zero seconds",
+			input: "17:45:00",
+			want:  "17:45",
+		},
+		{
+			name:  "Wrap fractional seconds",
+			input: "17:45:30.25",
+			want:  "17:45:30.25",
+		},
+		{
+			name:    "Wrap custom layout",
+			input:   "9:30 PM",
+			layouts: []string{"3:04 PM"},
+			want:    "21:30",
+		},
+		{
+			name:        "Wrap invalid hour",
+			input:       "24:00",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap invalid minute with discard",
+			input:       "09:60",
+			discard:     true,
+			wantError:   false,
+			wantDiscard: true,
+		},
+		{
+			name:  "Wrap TimeOfDay",
+			input: TimeOfDay{Hour: 8, Minute: 5},
+			want:  "08:05",
+		},
+		{
+			name:        "Wrap invalid TimeOfDay",
+			input:       TimeOfDay{Hour: 8, Minute: 75},
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:  "Wrap time.Time",
+			input: time.Date(2024, 3, 1, 23, 30, 15, 0, time.UTC),
+			want:  "23:30:15",
+		},
+		{
+			name:        "Wrap nil",
+			input:       nil,
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap unsupported type",
+			input:       930,
+			wantError:   true,
+			wantDiscard: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapper := New[*WrapperTimeOfDay]()
+			wrapper.SetLayouts(tt.layouts)
+
+			err := wrapper.Wrap(tt.input, tt.discard)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("Wrap() error = %v, wantError %v", err, tt.wantError)
+			}
+
+			if wrapper.IsDiscarded() != tt.wantDiscard {
+				t.Errorf("IsDiscarded() = %v, want %v", wrapper.IsDiscarded(), tt.wantDiscard)
+			}
+
+			if wrapper.Unwrap() != tt.want {
+				t.Errorf("Unwrap() = %v, want %v", wrapper.Unwrap(), tt.want)
+			}
+		})
+	}
+}
+
+// TestTimeOfDay_Compare tests ordering of times of day.
+func TestTimeOfDay_Compare(t *testing.T) {
+	opening := TimeOfDay{Hour: 9, Minute: 30}
+	closing := TimeOfDay{Hour: 17, Minute: 45, Second: 30}
+
+	if opening.Compare(closing) != -1 || closing.Compare(opening) != 1 || opening.Compare(opening) != 0 {
+		t.Errorf("Compare() returned unexpected results")
+	}
+
+	if !opening.Before(closing) || !closing.After(opening) {
+		t.Errorf("Before() / After() returned unexpected results")
+	}
+
+	on := opening.On(Date{Year: 2024, Month: time.May, Day: 1}, time.UTC)
+	if !on.Equal(time.Date(2024, 5, 1, 9, 30, 0, 0, time.UTC)) {
+		t.Errorf("On() = %v", on)
+	}
+}
+
+// TestWrapperTimeOfDay_JSON tests JSON marshalling and unmarshalling of WrapperTimeOfDay.
+func TestWrapperTimeOfDay_JSON(t *testing.T) {
+	type OpeningHours struct {
+		Opens  *WrapperTimeOfDay `json:"opens"`
+		Closes *WrapperTimeOfDay `json:"closes"`
+	}
+
+	var hours OpeningHours
+	if err := json.Unmarshal([]byte(`{"opens":"09:30","closes":"17:45:30"}`), &hours); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	data, err := json.Marshal(&hours)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	if string(data) != `{"opens":"09:30","closes":"17:45:30"}` {
+		t.Errorf("Marshalled JSON = %v", string(data))
+	}
+}
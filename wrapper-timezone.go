@@ -0,0 +1,176 @@
+package wrappers
+
+import (
+	"encoding/xml"
+	"fmt"
+	"time"
+	_ "time/tzdata" // Embed the time zone database so zones can be loaded on systems without one, e.g. minimal containers.
+)
+
+const (
+	WrapperTimezoneName    Name   = "WrapperTimezone"
+	WrapperTimezoneExample string = "Europe/Berlin, America/New_York, UTC"
+)
+
+// WrapperTimezone wraps an IANA time zone such as "Europe/Berlin" and exposes it as a *time.Location.
+type WrapperTimezone Wrapper[*time.Location, string]
+
+var _ WrapperProvider = (*WrapperTimezone)(nil) // Ensure that WrapperTimezone implements WrapperProvider.
+
+func (wrapper *WrapperTimezone) Get() *time.Location {
+	return wrapper.Value
+}
+
+func (wrapper *WrapperTimezone) GetAny() any {
+	return wrapper.Get()
+}
+
+func (wrapper *WrapperTimezone) Wrap(value any, discard bool) error {
+	switch v := value.(type) {
+	case nil:
+		wrapper.Discard()
+		if !discard {
+			return ErrorNil(WrapperTimezoneName)
+		}
+
+	case WrapperProvider:
+		if v.IsDiscarded() {
+			wrapper.Discard()
+			return nil
+		}
+
+		return wrapper.Wrap(v.UnwrapAny(), discard)
+
+	case *time.Location:
+		if v == nil {
+			wrapper.Discard()
+			if !discard {
+				return ErrorNil(WrapperTimezoneName)
+			}
+			return nil
+		}
+
+		return wrapper.Wrap(v.String(), discard)
+
+	case string:
+		if v == "" {
+			wrapper.Discard()
+			if !discard {
+				return ErrorNil(WrapperTimezoneName)
+			}
+			return nil
+		}
+
+		// The local zone depends on the system and is not an IANA name.
+		if v == "Local" {
+			wrapper.Discard()
+			if !discard {
+				return ErrorValue(WrapperTimezoneName, value, "IANA time zone name")
+			}
+			return nil
+		}
+
+		location, err := time.LoadLocation(v)
+		if err != nil {
+			wrapper.Discard()
+			if !discard {
+				return ErrorParse(WrapperTimezoneName, value, err)
+			}
+			return nil
+		}
+
+		wrapper.Value = location
+
+	default:
+		wrapper.Discard()
+		if !discard {
+			return ErrorType(WrapperTimezoneName, value)
+		}
+	}
+
+	return nil
+}
+
+func (wrapper *WrapperTimezone) Unwrap() string {
+	if wrapper.IsDiscarded() || wrapper.Value == nil {
+		return ""
+	}
+
+	return wrapper.Value.String()
+}
+
+func (wrapper *WrapperTimezone) UnwrapAny() any {
+	return wrapper.Unwrap()
+}
+
+func (wrapper *WrapperTimezone) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(wrapper)
+}
+
+func (wrapper *WrapperTimezone) UnmarshalJSON(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalJSON(data, wrapper)
+}
+
+func (wrapper *WrapperTimezone) MarshalText() ([]byte, error) {
+	return MarshalText(wrapper)
+}
+
+func (wrapper *WrapperTimezone) UnmarshalText(data []byte) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalText(data, wrapper)
+}
+
+func (wrapper *WrapperTimezone) Scan(src any) error {
+	if wrapper == nil {
+		return fmt.Errorf("scan into nil wrapper")
+	}
+
+	return Scan(src, wrapper)
+}
+
+func (wrapper *WrapperTimezone) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	return MarshalXML(encoder, start, wrapper)
+}
+
+func (wrapper *WrapperTimezone) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXML(decoder, start, wrapper)
+}
+
+func (wrapper *WrapperTimezone) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return MarshalXMLAttr(name, wrapper)
+}
+
+func (wrapper *WrapperTimezone) UnmarshalXMLAttr(attr xml.Attr) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return UnmarshalXMLAttr(attr, wrapper)
+}
+
+func (wrapper *WrapperTimezone) Set(value string) error {
+	if wrapper == nil {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	return Set(value, wrapper)
+}
+
+func (wrapper *WrapperTimezone) String() string {
+	return String(wrapper)
+}
+
+func (wrapper *WrapperTimezone) Schema() Schema {
+	return Schema{Type: "string", Examples: SchemaExamples(WrapperTimezoneExample, wrapper)}
+}
@@ -0,0 +1,111 @@
+package wrappers
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// TestWrapperTimezone_Wrap tests the Wrap method of WrapperTimezone.
+func TestWrapperTimezone_Wrap(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       any
+		discard     bool
+		want        string
+		wantError   bool
+		wantDiscard bool
+	}{
+		{
+			name:  "Wrap IANA zone",
+			input: "Europe/Berlin",
+			want:  "Europe/Berlin",
+		},
+		{
+			name:  "Wrap UTC",
+			input: "UTC",
+			want:  "UTC",
+		},
+		{
+			name:  "Wrap *time.Location",
+			input: time.UTC,
+			want:  "UTC",
+		},
+		{
+			name:        "Wrap unknown zone",
+			input:       "Mars/Olympus_Mons",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap unknown zone with discard",
+			input:       "Mars/Olympus_Mons",
+			discard:     true,
+			wantError:   false,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap local zone",
+			input:       "Local",
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap nil",
+			input:       nil,
+			wantError:   true,
+			wantDiscard: true,
+		},
+		{
+			name:        "Wrap unsupported type",
+			input:       2,
+			wantError:   true,
+			wantDiscard: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wrapper := New[*WrapperTimezone]()
+
+			err := wrapper.Wrap(tt.input, tt.discard)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("Wrap() error = %v, wantError %v", err, tt.wantError)
+			}
+
+			if wrapper.IsDiscarded() != tt.wantDiscard {
+				t.Errorf("IsDiscarded() = %v, want %v", wrapper.IsDiscarded(), tt.wantDiscard)
+			}
+
+			if wrapper.Unwrap() != tt.want {
+				t.Errorf("Unwrap() = %v, want %v", wrapper.Unwrap(), tt.want)
+			}
+		})
+	}
+}
+
+// TestWrapperTimezone_JSON tests JSON unmarshalling of WrapperTimezone and using its location.
+func TestWrapperTimezone_JSON(t *testing.T) {
+	type Schedule struct {
+		Timezone *WrapperTimezone `json:"timezone"`
+	}
+
+	var schedule Schedule
+	if err := json.Unmarshal([]byte(`{"timezone":"America/New_York"}`), &schedule); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	local := time.Date(2024, 7, 1, 12, 0, 0, 0, schedule.Timezone.Get())
+	if local.UTC().Hour() != 16 {
+		t.Errorf("UTC hour = %v, want 16", local.UTC().Hour())
+	}
+
+	data, err := json.Marshal(&schedule)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	if string(data) != `{"timezone":"America/New_York"}` {
+		t.Errorf("Marshalled JSON = %v", string(data))
+	}
+}
@@ -1,9 +1,11 @@
 package wrappers
 
 import (
+	"encoding"
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strconv"
 )
 
 const (
@@ -23,8 +25,9 @@ type Name string
 
 // WrapperBase is a struct that holds the basic fields of a wrapper. It is embedded in all wrapper implementations.
 type WrapperBase struct {
-	initialized bool // Indicates if the wrapper has been initialized.
-	discarded   bool // If this is true, unwrapping will return nil. This is useful when we want to discard for processes where we need to explicitly exclude data such as during an API call where we shouldn't send a field.
+	initialized bool           // Indicates if the wrapper has been initialized.
+	discarded   bool           // If this is true, unwrapping will return nil. This is useful when we want to discard for processes where we need to explicitly exclude data such as during an API call where we shouldn't send a field.
+	xmlDiscard  XMLDiscardMode // Defines how the wrapper is marshalled to XML when it is discarded.
 }
 
 func (wrapper *WrapperBase) Initialize() {
@@ -43,7 +46,26 @@ func (wrapper *WrapperBase) IsDiscarded() bool {
 	return wrapper.discarded
 }
 
+// undiscard resets the discarded flag. It is used before wrapping a new value, see wrap.
+func (wrapper *WrapperBase) undiscard() {
+	wrapper.discarded = false
+}
+
+// wrap wraps a new value into the wrapper. Wrap implementations only ever set the discarded flag,
+// so it is reset first. Otherwise a wrapper discarded by an earlier invalid value would stay discarded after a valid one.
+func wrap(wrapper WrapperProvider, value any) error {
+	if base, ok := wrapper.(interface{ undiscard() }); ok {
+		base.undiscard()
+	}
+
+	return wrapper.Wrap(value, false)
+}
+
 // WrapperProvider is an interface that defines the methods that a wrapper must implement.
+// The wrappers of this package implement further standard interfaces, which are detected with type assertions so that custom wrappers
+// implementing only WrapperProvider keep working: encoding.TextMarshaler and encoding.TextUnmarshaler, sql.Scanner, xml.Marshaler,
+// xml.Unmarshaler, xml.MarshalerAttr, xml.UnmarshalerAttr, flag.Value and SchemaProvider. Missing methods fall back to the generic
+// implementations of this package, e.g. MarshalText.
 type WrapperProvider interface {
 	// The initization methods are important in cases where parameters or other custom logic is needed before the wrapper can be used.
 	// An example of this would be derivitives of the WrapperRegex wrapper where we need to set the regex pattern before we can use the wrapper.
@@ -158,6 +180,68 @@ func UnmarshalJSON(data []byte, wrapper WrapperProvider) error {
 		return err
 	}
 
-	err := wrapper.Wrap(s, false)
-	return err
+	return wrap(wrapper, s)
+}
+
+// MarshalText is a generic implementation of the MarshalText method for wrappers. It is used to marshal a wrapper into its text representation.
+// All wrappers should call this method in their MarshalText implementation.
+func MarshalText(wrapper WrapperProvider) ([]byte, error) {
+	if reflect.ValueOf(wrapper).IsNil() {
+		return nil, fmt.Errorf("marshal into nil wrapper")
+	}
+
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+
+	if wrapper.IsDiscarded() {
+		return []byte{}, nil
+	}
+
+	switch v := wrapper.UnwrapAny().(type) {
+	case string:
+		return []byte(v), nil
+	case bool:
+		return []byte(strconv.FormatBool(v)), nil
+	case int64:
+		return []byte(strconv.FormatInt(v, 10)), nil
+	case float64:
+		return []byte(strconv.FormatFloat(v, 'f', -1, 64)), nil
+	default:
+		return []byte(fmt.Sprint(v)), nil
+	}
+}
+
+// UnmarshalText is a generic implementation of the UnmarshalText method for wrappers. It is used to unmarshal text into a wrapper.
+// All wrappers should call this method in their UnmarshalText implementation.
+func UnmarshalText(data []byte, wrapper WrapperProvider) error {
+	if reflect.ValueOf(wrapper).IsNil() {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+
+	return wrap(wrapper, string(data))
+}
+
+// marshalText marshals the wrapper to text with its own MarshalText method (see encoding.TextMarshaler) if it has one.
+// WrapperProvider does not require text support, so other wrappers fall back to the generic implementation.
+func marshalText(wrapper WrapperProvider) ([]byte, error) {
+	if marshaler, ok := wrapper.(encoding.TextMarshaler); ok {
+		return marshaler.MarshalText()
+	}
+
+	return MarshalText(wrapper)
+}
+
+// unmarshalText unmarshals text into the wrapper with its own UnmarshalText method (see encoding.TextUnmarshaler) if it has one
+// and falls back to the generic implementation otherwise.
+func unmarshalText(data []byte, wrapper WrapperProvider) error {
+	if unmarshaler, ok := wrapper.(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText(data)
+	}
+
+	return UnmarshalText(data, wrapper)
 }
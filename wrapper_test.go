@@ -1,7 +1,11 @@
 package wrappers
 
 import (
+	"database/sql"
+	"encoding"
 	"encoding/json"
+	"encoding/xml"
+	"flag"
 	"strconv"
 	"strings"
 	"testing"
@@ -10,6 +14,81 @@ import (
 	"github.com/biter777/countries"
 )
 
+// standardWrapper is implemented by the wrappers of this package. WrapperProvider does not include these interfaces,
+// so the capabilities are detected with type assertions when wrappers are proxied or bound.
+type standardWrapper interface {
+	WrapperProvider
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+	sql.Scanner
+	xml.Marshaler
+	xml.Unmarshaler
+	xml.MarshalerAttr
+	xml.UnmarshalerAttr
+	flag.Value
+	SchemaProvider
+}
+
+var _ = []standardWrapper{
+	(*WrapperBool)(nil), (*WrapperCountry)(nil), (*WrapperCron)(nil), (*WrapperDate)(nil), (*WrapperEmail)(nil), (*WrapperFloat)(nil),
+	(*WrapperInt)(nil), (*WrapperInterval)(nil), (*WrapperPhone)(nil), (*WrapperRecurrence)(nil), (*WrapperString)(nil),
+	(*WrapperTime)(nil), (*WrapperTimeISO8601)(nil), (*WrapperTimeDuration)(nil), (*WrapperTimeOfDay)(nil), (*WrapperTimezone)(nil),
+	(*WrapperUrl)(nil),
+}
+
+// wrapperMinimal only implements WrapperProvider, like custom wrappers written before the standard interfaces were added.
+type wrapperMinimal struct {
+	Wrapper[string, string]
+}
+
+func (wrapper *wrapperMinimal) Wrap(value any, discard bool) error {
+	text, ok := value.(string)
+	if !ok || text == "" {
+		wrapper.Discard()
+		if discard {
+			return nil
+		}
+		return ErrorValue("wrapperMinimal", value, "non-empty string")
+	}
+
+	wrapper.Value = text
+	return nil
+}
+
+func (wrapper *wrapperMinimal) MarshalJSON() ([]byte, error) { return MarshalJSON(wrapper) }
+func (wrapper *wrapperMinimal) UnmarshalJSON(data []byte) error {
+	return UnmarshalJSON(data, wrapper)
+}
+func (wrapper *wrapperMinimal) UnwrapAny() any { return wrapper.Value }
+func (wrapper *wrapperMinimal) GetAny() any    { return wrapper.Value }
+
+func TestWrapperMinimal(t *testing.T) {
+	type document struct {
+		Name *Discarder[*wrapperMinimal] `xml:"name"`
+	}
+
+	decoded := document{Name: NewDiscarder(&wrapperMinimal{})}
+	if err := xml.Unmarshal([]byte("<document><name>ada</name></document>"), &decoded); err != nil {
+		t.Fatalf("xml.Unmarshal() error = %v", err)
+	}
+
+	if decoded.Name.Proxy.Value != "ada" {
+		t.Errorf("Proxy.Value = %q, want %q", decoded.Name.Proxy.Value, "ada")
+	}
+
+	if text, err := decoded.Name.MarshalText(); err != nil || string(text) != "ada" {
+		t.Errorf("MarshalText() = %q, %v, want %q", text, err, "ada")
+	}
+
+	if err := decoded.Name.Scan([]byte("grace")); err != nil || decoded.Name.Proxy.Value != "grace" {
+		t.Errorf("Scan() = %v, Proxy.Value = %q, want %q", err, decoded.Name.Proxy.Value, "grace")
+	}
+
+	if schema := decoded.Name.Schema(); schema.Type != "" || !schema.Nullable {
+		t.Errorf("Schema() = %+v, want a nullable schema accepting any value", schema)
+	}
+}
+
 func TestNew(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -1460,3 +1539,96 @@ func TestExample_FullyDiscardedFields(t *testing.T) {
 	// Compare each field to ensure they're correctly discarded or unmarshalled
 	compareExamples(t, example, &unmarshalled)
 }
+
+// TestMarshallingText tests the MarshalText and UnmarshalText implementations of the built-in wrappers.
+func TestMarshallingText(t *testing.T) {
+	tests := []struct {
+		name        string
+		wrapper     standardWrapper
+		input       string
+		want        string
+		wantError   bool
+		wantDiscard bool
+	}{
+		{name: "WrapperBool", wrapper: New[*WrapperBool](), input: "yes", want: "true"},
+		{name: "WrapperBool invalid", wrapper: New[*WrapperBool](), input: "maybe", wantError: true, wantDiscard: true},
+		{name: "WrapperInt", wrapper: New[*WrapperInt](), input: "42", want: "42"},
+		{name: "WrapperInt invalid", wrapper: New[*WrapperInt](), input: "forty-two", wantError: true, wantDiscard: true},
+		{name: "WrapperFloat", wrapper: New[*WrapperFloat](), input: "1.5", want: "1.5"},
+		{name: "WrapperFloat large", wrapper: New[*WrapperFloat](), input: "1e21", want: "1000000000000000000000"},
+		{name: "WrapperString", wrapper: New[*WrapperString](), input: "Hello, World!", want: "Hello, World!"},
+		{name: "WrapperCountry", wrapper: New[*WrapperCountry](), input: "DE", want: "Germany"},
+		{name: "WrapperTime", wrapper: New[*WrapperTime](), input: "2024-01-01T12:00:00+02:00", want: "2024-01-01T12:00:00+02:00"},
+		{name: "WrapperTime invalid", wrapper: New[*WrapperTime](), input: "yesterday", wantError: true, wantDiscard: true},
+		{name: "WrapperTimeDuration", wrapper: New[*WrapperTimeDuration](), input: "PT1H30M", want: "1h30m0s"},
+		{name: "WrapperDate", wrapper: New[*WrapperDate](), input: "2024-02-29", want: "2024-02-29"},
+		{name: "WrapperTimezone", wrapper: New[*WrapperTimezone](), input: "Europe/Berlin", want: "Europe/Berlin"},
+		{name: "WrapperEmail", wrapper: New[*WrapperEmail](), input: "user@example.com", want: "user@example.com"},
+		{name: "WrapperCron", wrapper: New[*WrapperCron](), input: "@daily", want: "@daily"},
+		{name: "WrapperInterval", wrapper: New[*WrapperInterval](), input: "2024-01-01T10:00:00Z/PT2H", want: "2024-01-01T10:00:00Z/2024-01-01T12:00:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.wrapper.UnmarshalText([]byte(tt.input))
+			if (err != nil) != tt.wantError {
+				t.Fatalf("UnmarshalText() error = %v, wantError %v", err, tt.wantError)
+			}
+
+			if tt.wrapper.IsDiscarded() != tt.wantDiscard {
+				t.Errorf("IsDiscarded() = %v, want %v", tt.wrapper.IsDiscarded(), tt.wantDiscard)
+			}
+
+			text, err := tt.wrapper.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText() error = %v", err)
+			}
+
+			if string(text) != tt.want {
+				t.Errorf("MarshalText() = %q, want %q", string(text), tt.want)
+			}
+		})
+	}
+}
+
+// TestUnmarshallingClearsDiscard tests that unmarshalling a valid value into a discarded wrapper clears the discarded state.
+func TestUnmarshallingClearsDiscard(t *testing.T) {
+	wrapper := New[*WrapperInt]()
+	if err := wrapper.UnmarshalText([]byte("forty-two")); err == nil || !wrapper.IsDiscarded() {
+		t.Fatalf("UnmarshalText() error = %v, IsDiscarded() = %v, want an error and a discarded wrapper", err, wrapper.IsDiscarded())
+	}
+
+	if err := wrapper.UnmarshalText([]byte("42")); err != nil || wrapper.IsDiscarded() || wrapper.Get() != 42 {
+		t.Errorf("UnmarshalText() error = %v, IsDiscarded() = %v, Get() = %v, want 42", err, wrapper.IsDiscarded(), wrapper.Get())
+	}
+
+	wrapper.Discard()
+	if err := wrapper.UnmarshalJSON([]byte("7")); err != nil || wrapper.IsDiscarded() || wrapper.Get() != 7 {
+		t.Errorf("UnmarshalJSON() error = %v, IsDiscarded() = %v, Get() = %v, want 7", err, wrapper.IsDiscarded(), wrapper.Get())
+	}
+
+	if err := wrapper.UnmarshalJSON([]byte(`"seven"`)); err == nil || !wrapper.IsDiscarded() {
+		t.Errorf("UnmarshalJSON() error = %v, IsDiscarded() = %v, want an error and a discarded wrapper", err, wrapper.IsDiscarded())
+	}
+}
+
+// TestMarshallingTextUninitialized tests that MarshalText and UnmarshalText initialize wrappers and reject nil wrappers.
+func TestMarshallingTextUninitialized(t *testing.T) {
+	wrapper := &WrapperInt{}
+	if err := wrapper.UnmarshalText([]byte("7")); err != nil {
+		t.Fatalf("UnmarshalText() error = %v", err)
+	}
+
+	if !wrapper.IsInitialized() {
+		t.Errorf("IsInitialized() = false, want true")
+	}
+
+	var nilWrapper *WrapperInt
+	if err := nilWrapper.UnmarshalText([]byte("7")); err == nil {
+		t.Errorf("UnmarshalText() on nil wrapper expected error")
+	}
+
+	if _, err := MarshalText(nilWrapper); err == nil {
+		t.Errorf("MarshalText() on nil wrapper expected error")
+	}
+}
@@ -0,0 +1,153 @@
+package wrappers
+
+import (
+	"encoding/xml"
+	"fmt"
+	"reflect"
+)
+
+// XMLDiscardMode defines how discarded wrappers are marshalled to XML.
+type XMLDiscardMode int
+
+const (
+	XMLDiscardOmit  XMLDiscardMode = iota // Discarded wrappers are omitted, i.e. no element or attribute is written.
+	XMLDiscardEmpty                       // Discarded wrappers are written as empty elements or attributes.
+)
+
+// SetXMLDiscard configures how the wrapper is marshalled to XML when it is discarded. Defaults to XMLDiscardOmit.
+func (wrapper *WrapperBase) SetXMLDiscard(mode XMLDiscardMode) {
+	wrapper.xmlDiscard = mode
+}
+
+// XMLDiscard returns how the wrapper is marshalled to XML when it is discarded.
+func (wrapper *WrapperBase) XMLDiscard() XMLDiscardMode {
+	return wrapper.xmlDiscard
+}
+
+// xmlDiscardMode returns the discard mode of wrappers embedding WrapperBase and the default otherwise.
+func xmlDiscardMode(wrapper WrapperProvider) XMLDiscardMode {
+	if provider, ok := wrapper.(interface{ XMLDiscard() XMLDiscardMode }); ok {
+		return provider.XMLDiscard()
+	}
+
+	return XMLDiscardOmit
+}
+
+// MarshalXML is a generic implementation of the MarshalXML method for wrappers. It is used to marshal a wrapper into an XML element.
+// All wrappers should call this method in their MarshalXML implementation. The element content is the text representation of the wrapper.
+func MarshalXML(encoder *xml.Encoder, start xml.StartElement, wrapper WrapperProvider) error {
+	if reflect.ValueOf(wrapper).IsNil() {
+		return fmt.Errorf("marshal into nil wrapper")
+	}
+
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+
+	if wrapper.IsDiscarded() && xmlDiscardMode(wrapper) == XMLDiscardOmit {
+		return nil
+	}
+
+	text, err := MarshalText(wrapper)
+	if err != nil {
+		return err
+	}
+
+	return encoder.EncodeElement(string(text), start)
+}
+
+// UnmarshalXML is a generic implementation of the UnmarshalXML method for wrappers. It is used to unmarshal an XML element into a wrapper.
+// All wrappers should call this method in their UnmarshalXML implementation. The character data of the element is passed to Wrap as a string.
+func UnmarshalXML(decoder *xml.Decoder, start xml.StartElement, wrapper WrapperProvider) error {
+	if reflect.ValueOf(wrapper).IsNil() {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+
+	var content string
+	if err := decoder.DecodeElement(&content, &start); err != nil {
+		return err
+	}
+
+	return wrap(wrapper, content)
+}
+
+// MarshalXMLAttr is a generic implementation of the MarshalXMLAttr method for wrappers. It is used to marshal a wrapper into an XML attribute.
+// All wrappers should call this method in their MarshalXMLAttr implementation.
+func MarshalXMLAttr(name xml.Name, wrapper WrapperProvider) (xml.Attr, error) {
+	if reflect.ValueOf(wrapper).IsNil() {
+		return xml.Attr{}, fmt.Errorf("marshal into nil wrapper")
+	}
+
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+
+	if wrapper.IsDiscarded() && xmlDiscardMode(wrapper) == XMLDiscardOmit {
+		return xml.Attr{}, nil // An attribute without a name is not written.
+	}
+
+	text, err := MarshalText(wrapper)
+	if err != nil {
+		return xml.Attr{}, err
+	}
+
+	return xml.Attr{Name: name, Value: string(text)}, nil
+}
+
+// UnmarshalXMLAttr is a generic implementation of the UnmarshalXMLAttr method for wrappers. It is used to unmarshal an XML attribute into a wrapper.
+// All wrappers should call this method in their UnmarshalXMLAttr implementation.
+func UnmarshalXMLAttr(attr xml.Attr, wrapper WrapperProvider) error {
+	if reflect.ValueOf(wrapper).IsNil() {
+		return fmt.Errorf("unmarshal into nil wrapper")
+	}
+
+	if !wrapper.IsInitialized() {
+		wrapper.Initialize()
+	}
+
+	return wrap(wrapper, attr.Value)
+}
+
+// marshalXML marshals the wrapper to an XML element with its own MarshalXML method (see xml.Marshaler) if it has one
+// and falls back to the generic implementation otherwise.
+func marshalXML(encoder *xml.Encoder, start xml.StartElement, wrapper WrapperProvider) error {
+	if marshaler, ok := wrapper.(xml.Marshaler); ok {
+		return marshaler.MarshalXML(encoder, start)
+	}
+
+	return MarshalXML(encoder, start, wrapper)
+}
+
+// unmarshalXML unmarshals an XML element into the wrapper with its own UnmarshalXML method (see xml.Unmarshaler) if it has one
+// and falls back to the generic implementation otherwise.
+func unmarshalXML(decoder *xml.Decoder, start xml.StartElement, wrapper WrapperProvider) error {
+	if unmarshaler, ok := wrapper.(xml.Unmarshaler); ok {
+		return unmarshaler.UnmarshalXML(decoder, start)
+	}
+
+	return UnmarshalXML(decoder, start, wrapper)
+}
+
+// marshalXMLAttr marshals the wrapper to an XML attribute with its own MarshalXMLAttr method (see xml.MarshalerAttr) if it has one
+// and falls back to the generic implementation otherwise.
+func marshalXMLAttr(name xml.Name, wrapper WrapperProvider) (xml.Attr, error) {
+	if marshaler, ok := wrapper.(xml.MarshalerAttr); ok {
+		return marshaler.MarshalXMLAttr(name)
+	}
+
+	return MarshalXMLAttr(name, wrapper)
+}
+
+// unmarshalXMLAttr unmarshals an XML attribute into the wrapper with its own UnmarshalXMLAttr method (see xml.UnmarshalerAttr) if it has one
+// and falls back to the generic implementation otherwise.
+func unmarshalXMLAttr(attr xml.Attr, wrapper WrapperProvider) error {
+	if unmarshaler, ok := wrapper.(xml.UnmarshalerAttr); ok {
+		return unmarshaler.UnmarshalXMLAttr(attr)
+	}
+
+	return UnmarshalXMLAttr(attr, wrapper)
+}
@@ -0,0 +1,160 @@
+package wrappers
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+)
+
+type xmlPayment struct {
+	XMLName  xml.Name            `xml:"payment"`
+	ID       *WrapperInt         `xml:"id,attr"`
+	Currency *WrapperString      `xml:"currency,attr,omitempty"`
+	Amount   *WrapperFloat       `xml:"amount"`
+	Booked   *WrapperBool        `xml:"booked"`
+	Date     *WrapperDate        `xml:"date"`
+	Country  *WrapperCountry     `xml:"country"`
+	Note     *WrapperString      `xml:"note"`
+	Created  *WrapperTimeISO8601 `xml:"created"`
+}
+
+func TestXMLRoundTrip(t *testing.T) {
+	input := `<payment id="7" currency="EUR"><amount>12.5</amount><booked>true</booked><date>2024-03-01</date><country>DE</country><note>Rent</note><created>2024-03-01T12:30:00Z</created></payment>`
+
+	var payment xmlPayment
+	if err := xml.Unmarshal([]byte(input), &payment); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if payment.ID.Get() != 7 {
+		t.Errorf("ID = %v, want 7", payment.ID.Get())
+	}
+
+	if payment.Currency.Get() != "EUR" {
+		t.Errorf("Currency = %v, want EUR", payment.Currency.Get())
+	}
+
+	if payment.Amount.Get() != 12.5 {
+		t.Errorf("Amount = %v, want 12.5", payment.Amount.Get())
+	}
+
+	if !payment.Booked.Get() {
+		t.Errorf("Booked = false, want true")
+	}
+
+	if payment.Date.Unwrap() != "2024-03-01" {
+		t.Errorf("Date = %v, want 2024-03-01", payment.Date.Unwrap())
+	}
+
+	if payment.Country.Unwrap() != "Germany" {
+		t.Errorf("Country = %v, want Germany", payment.Country.Unwrap())
+	}
+
+	if !payment.Created.Get().Equal(time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)) {
+		t.Errorf("Created = %v, want 2024-03-01T12:30:00Z", payment.Created.Get())
+	}
+
+	output, err := xml.Marshal(&payment)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	want := `<payment id="7" currency="EUR"><amount>12.5</amount><booked>true</booked><date>2024-03-01</date><country>Germany</country><note>Rent</note><created>2024-03-01T12:30:00Z</created></payment>`
+	if string(output) != want {
+		t.Errorf("Marshal() = %s, want %s", output, want)
+	}
+}
+
+func TestXMLDiscarded(t *testing.T) {
+	tests := []struct {
+		name string
+		mode XMLDiscardMode
+		want string
+	}{
+		{
+			name: "Omit",
+			mode: XMLDiscardOmit,
+			want: `<payment id="7"><amount>12.5</amount></payment>`,
+		},
+		{
+			name: "Empty",
+			mode: XMLDiscardEmpty,
+			want: `<payment id="7" currency=""><amount>12.5</amount><note></note></payment>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payment := xmlPayment{
+				ID:       NewWithValueDiscard[*WrapperInt](int64(7)),
+				Currency: New[*WrapperString](),
+				Amount:   NewWithValueDiscard[*WrapperFloat](12.5),
+				Note:     New[*WrapperString](),
+			}
+
+			payment.Currency.Discard()
+			payment.Currency.SetXMLDiscard(tt.mode)
+			payment.Note.Discard()
+			payment.Note.SetXMLDiscard(tt.mode)
+
+			output, err := xml.Marshal(&payment)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+
+			if string(output) != tt.want {
+				t.Errorf("Marshal() = %s, want %s", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestXMLInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "Invalid element", input: `<payment id="7"><amount>abc</amount></payment>`},
+		{name: "Invalid attribute", input: `<payment id="seven"></payment>`},
+		{name: "Invalid date", input: `<payment><date>2024-02-30</date></payment>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var payment xmlPayment
+			if err := xml.Unmarshal([]byte(tt.input), &payment); err == nil {
+				t.Errorf("Unmarshal() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestXMLDiscarder(t *testing.T) {
+	type Data struct {
+		XMLName xml.Name                `xml:"data"`
+		Count   *Discarder[*WrapperInt] `xml:"count,attr"`
+		Amount  *Discarder[*WrapperInt] `xml:"amount"`
+	}
+
+	data := Data{
+		Count:  NewDiscarder(New[*WrapperInt]()),
+		Amount: NewDiscarder(New[*WrapperInt]()),
+	}
+
+	if err := xml.Unmarshal([]byte(`<data count="many"><amount>abc</amount></data>`), &data); err != nil {
+		t.Fatalf("Unmarshal() error = %v, want nil", err)
+	}
+
+	if !data.Count.Proxy.IsDiscarded() || !data.Amount.Proxy.IsDiscarded() {
+		t.Errorf("IsDiscarded() = %v, %v, want true, true", data.Count.Proxy.IsDiscarded(), data.Amount.Proxy.IsDiscarded())
+	}
+
+	output, err := xml.Marshal(&data)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	if string(output) != `<data></data>` {
+		t.Errorf("Marshal() = %s, want <data></data>", output)
+	}
+}